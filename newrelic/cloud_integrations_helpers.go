@@ -0,0 +1,193 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrtime"
+)
+
+// cloudIntegrationSpec maps a service block of a cloud integrations resource onto the
+// NerdGraph integration inputs. enableFunc adds the configured block to the integrations
+// input, disableFunc adds the service to the disable input.
+type cloudIntegrationSpec struct {
+	enableFunc  func([]interface{}, int)
+	disableFunc func(int)
+}
+
+type cloudIntegrationAction string
+
+const (
+	cloudIntegrationActionConfigure cloudIntegrationAction = "configure"
+	cloudIntegrationActionDisable   cloudIntegrationAction = "disable"
+)
+
+// cloudIntegrationsData is the subset of *schema.ResourceData needed to reconcile integrations.
+type cloudIntegrationsData interface {
+	GetOk(string) (interface{}, bool)
+	GetChange(string) (interface{}, interface{})
+}
+
+// reconcileCloudIntegrations configures every service block that is present in the
+// configuration, which covers newly added and changed blocks, and disables every service
+// block that was removed from it. It returns the action taken per service block.
+func reconcileCloudIntegrations(d cloudIntegrationsData, linkedAccountID int, specs map[string]cloudIntegrationSpec) map[string]cloudIntegrationAction {
+	actions := map[string]cloudIntegrationAction{}
+
+	for name, spec := range specs {
+		if v, ok := d.GetOk(name); ok {
+			spec.enableFunc(v.([]interface{}), linkedAccountID)
+			actions[name] = cloudIntegrationActionConfigure
+		} else if o, n := d.GetChange(name); len(n.([]interface{})) < len(o.([]interface{})) {
+			spec.disableFunc(linkedAccountID)
+			actions[name] = cloudIntegrationActionDisable
+		}
+	}
+
+	return actions
+}
+
+// buildCloudIntegrationMutationDiagnostics returns an error for each service a configure or disable
+// mutation failed for, naming the service so the failures of several services are all reported.
+func buildCloudIntegrationMutationDiagnostics(action cloudIntegrationAction, errs []cloud.CloudIntegrationMutationError) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range errs {
+		summary := fmt.Sprintf("failed to %s the %s integration: %s %s", action, e.IntegrationSlug, e.Type, e.Message)
+		if e.IntegrationSlug == "" {
+			summary = fmt.Sprintf("failed to %s integrations: %s %s", action, e.Type, e.Message)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+		})
+	}
+
+	return diags
+}
+
+// readAppliedCloudIntegrations reconciles the state of a cloud integrations resource with the services
+// actually applied once some of them failed. The linked account only returns the integrations it has,
+// so every service block is cleared before reading it, leaving out the services that failed to be
+// enabled.
+func readAppliedCloudIntegrations(ctx context.Context, d *schema.ResourceData, meta interface{}, r *schema.Resource) diag.Diagnostics {
+	for name, s := range r.Schema {
+		if _, ok := s.Elem.(*schema.Resource); ok && s.Type == schema.TypeList && s.Optional {
+			_ = d.Set(name, nil)
+		}
+	}
+
+	return r.ReadContext(ctx, d, meta)
+}
+
+// cloudIntegrationStatusSchema describes the computed status of every integration enabled on a
+// linked account, as reported by the linked account query.
+func cloudIntegrationStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The status of the integrations enabled on the linked account.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The slug of the cloud service the integration monitors.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the integration.",
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the cloud service is enabled for integrating.",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "When the integration was created, in RFC3339 format.",
+				},
+				"updated_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "When the integration was last updated, in RFC3339 format.",
+				},
+			},
+		},
+	}
+}
+
+// flattenCloudIntegrationStatus returns the status of each integration, sorted by service slug.
+func flattenCloudIntegrationStatus(integrations []cloud.CloudIntegrationInterface) []interface{} {
+	out := []interface{}{}
+
+	for _, i := range integrations {
+		if status, ok := cloudIntegrationStatus(i); ok {
+			out = append(out, status)
+		}
+	}
+
+	sort.SliceStable(out, func(a, b int) bool {
+		return out[a].(map[string]interface{})["service"].(string) < out[b].(map[string]interface{})["service"].(string)
+	})
+
+	return out
+}
+
+// cloudIntegrationStatus reads the fields shared by every integration type. The client generates one
+// struct per integration without a common accessor, so the shared fields are looked up by name.
+func cloudIntegrationStatus(i cloud.CloudIntegrationInterface) (map[string]interface{}, bool) {
+	v := reflect.Indirect(reflect.ValueOf(i))
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	field := func(name string) interface{} {
+		if f := v.FieldByName(name); f.IsValid() {
+			return f.Interface()
+		}
+		return nil
+	}
+
+	service, ok := field("Service").(cloud.CloudService)
+	if !ok {
+		return nil, false
+	}
+
+	status := map[string]interface{}{
+		"service":    service.Slug,
+		"enabled":    service.IsEnabled,
+		"name":       "",
+		"created_at": "",
+		"updated_at": "",
+	}
+
+	if name, ok := field("Name").(string); ok {
+		status["name"] = name
+	}
+	if createdAt, ok := field("CreatedAt").(nrtime.EpochSeconds); ok {
+		status["created_at"] = formatCloudIntegrationTime(createdAt)
+	}
+	if updatedAt, ok := field("UpdatedAt").(nrtime.EpochSeconds); ok {
+		status["updated_at"] = formatCloudIntegrationTime(updatedAt)
+	}
+
+	return status, true
+}
+
+func formatCloudIntegrationTime(t nrtime.EpochSeconds) string {
+	if time.Time(t).IsZero() {
+		return ""
+	}
+
+	return time.Time(t).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,340 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock of the old and new values of the service blocks of a cloud integrations resource.
+type testCloudIntegrationsData struct {
+	old map[string][]interface{}
+	new map[string][]interface{}
+}
+
+func (m testCloudIntegrationsData) GetOk(key string) (interface{}, bool) {
+	v := m.new[key]
+	return v, len(v) > 0
+}
+
+func (m testCloudIntegrationsData) GetChange(key string) (interface{}, interface{}) {
+	o, n := m.old[key], m.new[key]
+	if o == nil {
+		o = []interface{}{}
+	}
+	if n == nil {
+		n = []interface{}{}
+	}
+	return o, n
+}
+
+func testCloudIntegrationSpecs(configured map[string][]interface{}, disabled map[string]bool, services ...string) map[string]cloudIntegrationSpec {
+	specs := map[string]cloudIntegrationSpec{}
+	for _, service := range services {
+		service := service
+		specs[service] = cloudIntegrationSpec{
+			enableFunc: func(a []interface{}, id int) {
+				configured[service] = a
+			},
+			disableFunc: func(id int) {
+				disabled[service] = true
+			},
+		}
+	}
+	return specs
+}
+
+func TestReconcileCloudIntegrations(t *testing.T) {
+	sqsConfig := []interface{}{map[string]interface{}{"metrics_polling_interval": 300}}
+	sqsReconfigured := []interface{}{map[string]interface{}{"metrics_polling_interval": 900}}
+	ebsConfig := []interface{}{map[string]interface{}{"metrics_polling_interval": 300}}
+
+	cases := map[string]struct {
+		data               testCloudIntegrationsData
+		expectedActions    map[string]cloudIntegrationAction
+		expectedConfigured map[string][]interface{}
+		expectedDisabled   map[string]bool
+	}{
+		"enable": {
+			data: testCloudIntegrationsData{
+				new: map[string][]interface{}{"sqs": sqsConfig, "ebs": ebsConfig},
+			},
+			expectedActions: map[string]cloudIntegrationAction{
+				"sqs": cloudIntegrationActionConfigure,
+				"ebs": cloudIntegrationActionConfigure,
+			},
+			expectedConfigured: map[string][]interface{}{"sqs": sqsConfig, "ebs": ebsConfig},
+			expectedDisabled:   map[string]bool{},
+		},
+		"disable": {
+			data: testCloudIntegrationsData{
+				old: map[string][]interface{}{"sqs": sqsConfig, "ebs": ebsConfig},
+				new: map[string][]interface{}{"ebs": ebsConfig},
+			},
+			expectedActions: map[string]cloudIntegrationAction{
+				"sqs": cloudIntegrationActionDisable,
+				"ebs": cloudIntegrationActionConfigure,
+			},
+			expectedConfigured: map[string][]interface{}{"ebs": ebsConfig},
+			expectedDisabled:   map[string]bool{"sqs": true},
+		},
+		"reconfigure": {
+			data: testCloudIntegrationsData{
+				old: map[string][]interface{}{"sqs": sqsConfig},
+				new: map[string][]interface{}{"sqs": sqsReconfigured},
+			},
+			expectedActions: map[string]cloudIntegrationAction{
+				"sqs": cloudIntegrationActionConfigure,
+			},
+			expectedConfigured: map[string][]interface{}{"sqs": sqsReconfigured},
+			expectedDisabled:   map[string]bool{},
+		},
+		"untouched": {
+			data:               testCloudIntegrationsData{},
+			expectedActions:    map[string]cloudIntegrationAction{},
+			expectedConfigured: map[string][]interface{}{},
+			expectedDisabled:   map[string]bool{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			configured := map[string][]interface{}{}
+			disabled := map[string]bool{}
+			specs := testCloudIntegrationSpecs(configured, disabled, "sqs", "ebs", "alb")
+
+			actions := reconcileCloudIntegrations(tc.data, 123, specs)
+
+			require.Equal(t, tc.expectedActions, actions)
+			require.Equal(t, tc.expectedConfigured, configured)
+			require.Equal(t, tc.expectedDisabled, disabled)
+		})
+	}
+}
+
+func TestExpandCloudAwsIntegrationsInput_DisableRemovedService(t *testing.T) {
+	r := resourceNewRelicCloudAwsIntegrations()
+	state := &terraform.InstanceState{
+		ID: "123",
+		Attributes: map[string]string{
+			"linked_account_id":              "123",
+			"sqs.#":                          "1",
+			"sqs.0.metrics_polling_interval": "300",
+			"ebs.#":                          "1",
+			"ebs.0.metrics_polling_interval": "300",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"sqs.#":                          {Old: "1", New: "0"},
+			"sqs.0.metrics_polling_interval": {Old: "300", New: "", NewRemoved: true},
+		},
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.NoError(t, err)
+
+	configureInput, disableInput := expandCloudAwsIntegrationsInput(d)
+
+	require.Empty(t, configureInput.Aws.Sqs)
+	require.Len(t, configureInput.Aws.Ebs, 1)
+	require.Equal(t, []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: 123}}, disableInput.Aws.Sqs)
+	require.Empty(t, disableInput.Aws.Ebs)
+}
+
+// Mock of the integrations returned by the linked account query.
+const testCloudLinkedAccountIntegrationsResponse = `{
+	"id": 123,
+	"nrAccountId": 1,
+	"integrations": [
+		{
+			"__typename": "CloudSqsIntegration",
+			"createdAt": 1672531200,
+			"id": 10,
+			"name": "SQS",
+			"service": {"isEnabled": true, "slug": "sqs"},
+			"updatedAt": 1675209600,
+			"metricsPollingInterval": 300
+		},
+		{
+			"__typename": "CloudAlbIntegration",
+			"createdAt": 1672531200,
+			"id": 11,
+			"name": "ALB",
+			"service": {"isEnabled": false, "slug": "alb"},
+			"updatedAt": 1672531200,
+			"metricsPollingInterval": 300
+		}
+	]
+}`
+
+func TestFlattenCloudIntegrationStatus(t *testing.T) {
+	var linkedAccount cloud.CloudLinkedAccount
+	require.NoError(t, json.Unmarshal([]byte(testCloudLinkedAccountIntegrationsResponse), &linkedAccount))
+	require.Len(t, linkedAccount.Integrations, 2)
+
+	d := resourceNewRelicCloudAwsIntegrations().TestResourceData()
+	flattenCloudAwsLinkedAccount(d, &linkedAccount)
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"service":    "alb",
+			"name":       "ALB",
+			"enabled":    false,
+			"created_at": "2023-01-01T00:00:00Z",
+			"updated_at": "2023-01-01T00:00:00Z",
+		},
+		map[string]interface{}{
+			"service":    "sqs",
+			"name":       "SQS",
+			"enabled":    true,
+			"created_at": "2023-01-01T00:00:00Z",
+			"updated_at": "2023-02-01T00:00:00Z",
+		},
+	}, d.Get("integration_status"))
+}
+
+func TestFlattenCloudIntegrationStatus_Empty(t *testing.T) {
+	require.Equal(t, []interface{}{}, flattenCloudIntegrationStatus(nil))
+}
+
+func TestFlattenCloudGcpLinkedAccount_RebuildsEveryService(t *testing.T) {
+	r := resourceNewrelicCloudGcpIntegrations()
+	d := r.TestResourceData()
+
+	flattenCloudGcpLinkedAccount(d, &cloud.CloudLinkedAccount{
+		ID:          123,
+		NrAccountId: 1,
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudGcpAlloydbIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpAppengineIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpBigqueryIntegration{MetricsPollingInterval: 300, FetchTags: true},
+			&cloud.CloudGcpBigtableIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpComposerIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpDataflowIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpDataprocIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpDatastoreIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpFirebasedatabaseIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpFirebasehostingIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpFirebasestorageIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpFirestoreIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpFunctionsIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpInterconnectIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpKubernetesIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpLoadbalancingIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpMemcacheIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpPubsubIntegration{MetricsPollingInterval: 300, FetchTags: true},
+			&cloud.CloudGcpRedisIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpRouterIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpRunIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpSpannerIntegration{MetricsPollingInterval: 300, FetchTags: true},
+			&cloud.CloudGcpSqlIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpStorageIntegration{MetricsPollingInterval: 300, FetchTags: true},
+			&cloud.CloudGcpVmsIntegration{MetricsPollingInterval: 300},
+			&cloud.CloudGcpVpcaccessIntegration{MetricsPollingInterval: 300},
+		},
+	})
+
+	require.Equal(t, 123, d.Get("linked_account_id"))
+	require.Equal(t, 1, d.Get("account_id"))
+
+	// Every service block, with every setting it supports, is rebuilt so an import plans clean.
+	for service, s := range r.Schema {
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok || s.Type != schema.TypeList || !s.Optional {
+			continue
+		}
+
+		require.Len(t, d.Get(service).([]interface{}), 1, service)
+		for attr := range elem.Schema {
+			require.NotZero(t, d.Get(service+".0."+attr), service+".0."+attr)
+		}
+	}
+}
+
+func TestBuildCloudIntegrationMutationDiagnostics(t *testing.T) {
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, []cloud.CloudIntegrationMutationError{
+		{IntegrationSlug: "sqs", Type: "ERROR", Message: "missing permissions"},
+		{IntegrationSlug: "rds", Type: "ERROR", Message: "invalid region"},
+		{Type: "ERROR", Message: "linked account is disabled"},
+	})
+
+	require.Len(t, diags, 3)
+	require.Equal(t, "failed to configure the sqs integration: ERROR missing permissions", diags[0].Summary)
+	require.Equal(t, "failed to configure the rds integration: ERROR invalid region", diags[1].Summary)
+	require.Equal(t, "failed to configure integrations: ERROR linked account is disabled", diags[2].Summary)
+
+	require.Empty(t, buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, nil))
+}
+
+// Mock of a linked account on which the S3 integration can be configured, while configuring the SQS
+// integration fails.
+func testMockCloudIntegrationsProviderConfig(t *testing.T) *ProviderConfig {
+	return testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		s3 := `{"__typename":"CloudS3Integration","id":1,"name":"S3","metricsPollingInterval":300,"service":{"slug":"s3","isEnabled":true}}`
+
+		switch {
+		case strings.Contains(body.Query, "cloudDisableIntegration"):
+			_, _ = w.Write([]byte(`{"data":{"cloudDisableIntegration":{"errors":[],"disabledIntegrations":[]}}}`))
+		case strings.Contains(body.Query, "cloudConfigureIntegration"):
+			_, _ = w.Write([]byte(`{"data":{"cloudConfigureIntegration":{"errors":[{"integrationSlug":"sqs","linkedAccountId":123,"message":"missing permissions","nrAccountId":1,"type":"ERROR"}],"integrations":[` + s3 + `]}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"cloud":{"linkedAccount":{"id":123,"nrAccountId":1,"integrations":[` + s3 + `]}}}}}}`))
+		}
+	})
+}
+
+func testCloudAwsIntegrationsS3AndSqs(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, resourceNewRelicCloudAwsIntegrations().Schema, map[string]interface{}{
+		"linked_account_id": 123,
+		"s3": []interface{}{
+			map[string]interface{}{"metrics_polling_interval": 300},
+		},
+		"sqs": []interface{}{
+			map[string]interface{}{"metrics_polling_interval": 300},
+		},
+	})
+}
+
+func TestCloudAwsIntegrations_FailingServiceDoesNotAbortOthers(t *testing.T) {
+	t.Parallel()
+
+	meta := testMockCloudIntegrationsProviderConfig(t)
+
+	for name, apply := range map[string]schema.CreateContextFunc{
+		"create": resourceNewRelicCloudAwsIntegrationsCreate,
+		"update": resourceNewRelicCloudAwsIntegrationsUpdate,
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := testCloudAwsIntegrationsS3AndSqs(t)
+			if name == "update" {
+				d.SetId("123")
+			}
+
+			diags := apply(context.Background(), d, meta)
+			require.Len(t, diags, 1)
+			require.Equal(t, "failed to configure the sqs integration: ERROR missing permissions", diags[0].Summary)
+
+			// The state only holds the service that was applied
+			require.Equal(t, "123", d.Id())
+			require.Len(t, d.Get("s3").([]interface{}), 1)
+			require.Empty(t, d.Get("sqs").([]interface{}))
+		})
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"compress/gzip"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/mitchellh/go-homedir"
@@ -18,6 +21,8 @@ import (
 	nr "github.com/newrelic/newrelic-client-go/v2/newrelic"
 )
 
+var errPersonalAPIKeyRequired = errors.New("a personal API key is required for NerdGraph calls")
+
 // Config contains New Relic provider settings
 type Config struct {
 	AdminAPIKey          string
@@ -33,13 +38,21 @@ type Config struct {
 	InsightsQueryKey     string
 	InsightsQueryURL     string
 	NerdGraphAPIURL      string
+	ProxyURL             string
 	SyntheticsAPIURL     string
+	MaxRetries           int
+	RetryBackoff         time.Duration
 	userAgent            string
 	serviceName          string
 }
 
 // Client returns a new client for accessing New Relic
 func (c *Config) Client() (*nr.NewRelic, error) {
+	// The client would otherwise send an empty Api-Key header, which NerdGraph only answers with a 401.
+	if strings.TrimSpace(c.PersonalAPIKey) == "" {
+		return nil, errPersonalAPIKeyRequired
+	}
+
 	options := []nr.ConfigOption{}
 
 	options = append(options,
@@ -50,24 +63,9 @@ func (c *Config) Client() (*nr.NewRelic, error) {
 		nr.ConfigRegion(c.Region),
 	)
 
-	tlsCfg := &tls.Config{}
-	var t = http.DefaultTransport
-
-	if c.CACertFile != "" {
-		caCert, _, err := read(c.CACertFile)
-		if err != nil {
-			log.Printf("Error reading CA Cert: %s", err)
-			return nil, err
-		}
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM([]byte(caCert))
-		tlsCfg.RootCAs = caCertPool
-
-		t = &http.Transport{TLSClientConfig: tlsCfg}
-	} else if c.InsecureSkipVerify {
-		tlsCfg.InsecureSkipVerify = true
-
-		t = &http.Transport{TLSClientConfig: tlsCfg}
+	t, err := c.httpTransport()
+	if err != nil {
+		return nil, err
 	}
 
 	if logging.LogLevel() != "" {
@@ -75,6 +73,10 @@ func (c *Config) Client() (*nr.NewRelic, error) {
 		t = logging.NewTransport("newrelic", t)
 	}
 
+	if c.MaxRetries > 0 {
+		t = newRetryTransport(t, c.MaxRetries, c.RetryBackoff)
+	}
+
 	options = append(options, nr.ConfigHTTPTransport(t))
 
 	if c.APIURL != "" {
@@ -104,6 +106,44 @@ func (c *Config) Client() (*nr.NewRelic, error) {
 	return client, nil
 }
 
+// httpTransport returns the transport of the client, configured with the CA certificate, the TLS
+// verification and the proxy of the provider.
+func (c *Config) httpTransport() (http.RoundTripper, error) {
+	tlsCfg := &tls.Config{}
+	var t = http.DefaultTransport
+
+	if c.CACertFile != "" {
+		caCert, _, err := read(c.CACertFile)
+		if err != nil {
+			log.Printf("Error reading CA Cert: %s", err)
+			return nil, err
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM([]byte(caCert))
+		tlsCfg.RootCAs = caCertPool
+	}
+
+	if c.CACertFile != "" || c.InsecureSkipVerify {
+		tlsCfg.InsecureSkipVerify = c.InsecureSkipVerify
+
+		t = &http.Transport{TLSClientConfig: tlsCfg}
+	}
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			// The parsing error holds the URL, so it would leak the credentials of the proxy
+			return nil, errors.New("error parsing proxy URL")
+		}
+
+		proxied := t.(*http.Transport).Clone()
+		proxied.Proxy = http.ProxyURL(proxyURL)
+		t = proxied
+	}
+
+	return t, nil
+}
+
 // ClientInsightsInsert returns a new Insights insert client
 func (c *Config) ClientInsightsInsert() (*insights.InsertClient, error) {
 	client := insights.NewInsertClient(c.InsightsInsertKey, c.InsightsAccountID)
@@ -136,7 +176,10 @@ type ProviderConfig struct {
 	InsightsInsertClient *insights.InsertClient
 	AccountID            int
 	PersonalAPIKey       string
+	DefaultTags          map[string]string
 	userAgent            string
+
+	dataPartitionRuleMutations dataPartitionRuleLimiter
 }
 
 func (p *ProviderConfig) GetUserAgent() string {
@@ -0,0 +1,159 @@
+package newrelic
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Upper bound of the `max_retries` provider attribute.
+	retryTransportMaxRetries = 10
+	// The backoff stops doubling after this many retries.
+	retryBackoffMaxShift = 6
+)
+
+// retryTransport retries requests rate limited by New Relic (429) with an exponential backoff plus
+// jitter, or after the delay of the Retry-After header when there is one. Requests failed by a
+// server error (5xx) are only retried when sending them again has no side effect, so never for
+// NerdGraph mutations. These retries come on top of the retries made by newrelic-client-go.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, backoff time.Duration) http.RoundTripper {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body is replayed on every attempt.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		r := req.Clone(ctx)
+		if body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		resp, err := t.next.RoundTrip(r)
+		if err != nil || !isRetryableResponse(resp, isIdempotentRequest(req.Method, body)) || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		wait, ok := retryAfter(resp)
+		if !ok {
+			wait = retryBackoff(t.backoff, attempt)
+		}
+
+		// Retrying would outlive the request, so the last response is returned as is.
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, nil
+		}
+
+		log.Printf("[DEBUG] %s %s returned %d, retrying in %s (%d/%d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt+1, t.maxRetries)
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryableResponse returns whether a response is worth retrying: a rate limited request always
+// is, a server error only when the request is idempotent.
+func isRetryableResponse(resp *http.Response, idempotent bool) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return idempotent && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// isIdempotentRequest returns whether a request can be sent again after a server error. NerdGraph
+// sends both queries and mutations as POST requests, so a POST request is only idempotent when its
+// body is a NerdGraph query.
+func isIdempotentRequest(method string, body []byte) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		var request struct {
+			Query string `json:"query"`
+		}
+		if err := json.Unmarshal(body, &request); err != nil || request.Query == "" {
+			return false
+		}
+
+		return !strings.HasPrefix(strings.TrimSpace(request.Query), "mutation")
+	}
+
+	return false
+}
+
+// retryAfter returns the delay requested by the Retry-After header of a response, given either in
+// seconds or as a date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// retryBackoff doubles the backoff on every attempt, up to retryBackoffMaxShift times, and adds up to
+// half of it again as jitter, so concurrent requests don't retry in lockstep.
+func retryBackoff(backoff time.Duration, attempt int) time.Duration {
+	if attempt > retryBackoffMaxShift {
+		attempt = retryBackoffMaxShift
+	}
+
+	wait := backoff << attempt
+	if wait <= 0 {
+		return 0
+	}
+
+	return wait + time.Duration(rand.Int63n(int64(wait)/2+1))
+}
@@ -0,0 +1,205 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport_RetriesRateLimitedRequests(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"query":"{ actor { user { id } } }"}`, string(body))
+
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"{ actor { user { id } } }"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_ReturnsLastResponseOnceExhausted(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 2, time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_DoesNotRetryClientErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_DoesNotRetryMutationsOnServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"mutation { alertsPolicyDelete(accountId: 1, id: 1) { id } }"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_RetriesRateLimitedMutationsAfterRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	// The Retry-After header replaces the backoff, which would otherwise exceed the test timeout.
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Hour)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query":"mutation { alertsPolicyDelete(accountId: 1, id: 1) { id } }"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransport_HonorsContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 5, time.Minute)}
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	for attempt, base := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		wait := retryBackoff(time.Second, attempt)
+		require.GreaterOrEqual(t, wait, base)
+		require.LessOrEqual(t, wait, base+base/2)
+	}
+
+	require.Zero(t, retryBackoff(0, 2))
+
+	capped := time.Second << retryBackoffMaxShift
+	wait := retryBackoff(time.Second, 100)
+	require.GreaterOrEqual(t, wait, capped)
+	require.LessOrEqual(t, wait, capped+capped/2)
+}
+
+func TestIsIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isIdempotentRequest(http.MethodGet, nil))
+	require.True(t, isIdempotentRequest(http.MethodPost, []byte(`{"query":"{ actor { user { id } } }"}`)))
+	require.True(t, isIdempotentRequest(http.MethodPost, []byte(`{"query":"query { actor { user { id } } }"}`)))
+	require.False(t, isIdempotentRequest(http.MethodPost, []byte(`{"query":" mutation { alertsPolicyDelete(accountId: 1, id: 1) { id } }"}`)))
+	require.False(t, isIdempotentRequest(http.MethodPost, []byte(`{"name":"policy"}`)))
+	require.False(t, isIdempotentRequest(http.MethodPatch, nil))
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	header := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+
+	wait, ok := retryAfter(header("3"))
+	require.True(t, ok)
+	require.Equal(t, 3*time.Second, wait)
+
+	wait, ok = retryAfter(header(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)))
+	require.True(t, ok)
+	require.Zero(t, wait)
+
+	_, ok = retryAfter(header("soon"))
+	require.False(t, ok)
+
+	_, ok = retryAfter(&http.Response{Header: http.Header{}})
+	require.False(t, ok)
+}
@@ -0,0 +1,129 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/logconfigurations"
+)
+
+func dataSourceNewRelicDataPartitionRule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicDataPartitionRuleRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account id associated with the data partition rule.",
+			},
+			"target_data_partition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the data partition the rule allocates logs to.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the data partition rule.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not this data partition rule is enabled.",
+			},
+			"nrql": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The NRQL to match events for this data partition rule.",
+			},
+			"retention_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The retention policy of the data partition data.",
+			},
+			"attribute_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attribute name against which the matching criteria of the data partition rule is evaluated.",
+			},
+			"matching_method": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The matching method of the data partition rule matching criteria.",
+			},
+			"matching_expression": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The matching expression of the data partition rule matching criteria.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicDataPartitionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic data partition rules")
+
+	rules, err := client.Logconfigurations.GetDataPartitionRulesWithContext(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rules == nil {
+		return diag.FromErr(fmt.Errorf("GetDataPartitionRules response was nil"))
+	}
+
+	rule, err := findDataPartitionRuleByTarget(*rules, d.Get("target_data_partition").(string), accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(rule.ID)
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("target_data_partition", string(rule.TargetDataPartition))
+	_ = d.Set("description", rule.Description)
+	_ = d.Set("enabled", rule.Enabled)
+	_ = d.Set("nrql", string(rule.NRQL))
+	_ = d.Set("retention_policy", string(rule.RetentionPolicy))
+	_ = d.Set("attribute_name", rule.MatchingCriteria.AttributeName)
+	_ = d.Set("matching_method", string(rule.MatchingCriteria.MatchingOperator))
+	_ = d.Set("matching_expression", rule.MatchingCriteria.MatchingExpression)
+
+	return nil
+}
+
+// findDataPartitionRuleByTarget returns the single rule that isn't deleted allocating logs to the
+// given data partition. Multiple matches are reported as an error listing their IDs.
+func findDataPartitionRuleByTarget(rules []logconfigurations.LogConfigurationsDataPartitionRule, target string, accountID int) (*logconfigurations.LogConfigurationsDataPartitionRule, error) {
+	var matches []logconfigurations.LogConfigurationsDataPartitionRule
+
+	for _, r := range rules {
+		if r.Deleted || string(r.TargetDataPartition) != target {
+			continue
+		}
+
+		matches = append(matches, r)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no data partition rule found with target data partition '%s' in account %d", target, accountID)
+	case 1:
+		return &matches[0], nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+
+	return nil, fmt.Errorf("found %d data partition rules with target data partition '%s' in account %d: %s", len(matches), target, accountID, strings.Join(ids, ", "))
+}
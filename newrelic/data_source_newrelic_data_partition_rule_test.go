@@ -0,0 +1,47 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicDataPartitionRuleDataSource_Basic(t *testing.T) {
+	resourceName := "newrelic_data_partition_rule.foo"
+	dataSourceName := "data.newrelic_data_partition_rule.rule"
+	rName := acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccLogDataPartitionsCleanup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicDataPartitionRuleDestroy,
+		Steps: []resource.TestStep{
+			//read
+			{
+				Config: testAccNewRelicDataPartitionRuleDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enabled", resourceName, "enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "retention_policy", resourceName, "retention_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "matching_expression", resourceName, "matching_expression"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicDataPartitionRuleDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "newrelic_data_partition_rule" "rule" {
+	account_id = %[2]d
+	target_data_partition = newrelic_data_partition_rule.foo.target_data_partition
+}
+`, testAccNewRelicDataPartitionRuleConfig(name), testAccountID)
+}
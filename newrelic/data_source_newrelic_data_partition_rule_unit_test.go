@@ -0,0 +1,43 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/logconfigurations"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataPartitionRules = []logconfigurations.LogConfigurationsDataPartitionRule{
+	{ID: "1", TargetDataPartition: "Log_Nginx", NRQL: "logtype = 'nginx'", Deleted: true},
+	{ID: "2", TargetDataPartition: "Log_Nginx", NRQL: "logtype = 'nginx'", Enabled: true},
+	{ID: "3", TargetDataPartition: "Log_Node", NRQL: "logtype = 'node'"},
+	{ID: "4", TargetDataPartition: "Log_Node", NRQL: "logtype = 'nodejs'"},
+}
+
+func TestFindDataPartitionRuleByTarget(t *testing.T) {
+	t.Parallel()
+
+	rule, err := findDataPartitionRuleByTarget(testDataPartitionRules, "Log_Nginx", 1)
+
+	require.NoError(t, err)
+	require.Equal(t, "2", rule.ID)
+}
+
+func TestFindDataPartitionRuleByTarget_NotFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := findDataPartitionRuleByTarget(testDataPartitionRules, "Log_Java", 1)
+
+	require.EqualError(t, err, "no data partition rule found with target data partition 'Log_Java' in account 1")
+}
+
+func TestFindDataPartitionRuleByTarget_MultipleMatches(t *testing.T) {
+	t.Parallel()
+
+	_, err := findDataPartitionRuleByTarget(testDataPartitionRules, "Log_Node", 1)
+
+	require.EqualError(t, err, "found 2 data partition rules with target data partition 'Log_Node' in account 1: 3, 4")
+}
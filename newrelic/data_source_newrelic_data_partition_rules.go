@@ -0,0 +1,140 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/logconfigurations"
+)
+
+func dataSourceNewRelicDataPartitionRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicDataPartitionRulesRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account id associated with the data partition rules.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return the data partition rules that are enabled, or only those that are disabled.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data partition rules, sorted by target data partition.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the data partition rule.",
+						},
+						"target_data_partition": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the data partition the rule allocates logs to.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether or not this data partition rule is enabled.",
+						},
+						"retention_policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The retention policy of the data partition data.",
+						},
+						"attribute_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The attribute name against which the matching criteria of the data partition rule is evaluated.",
+						},
+						"matching_expression": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The matching expression of the data partition rule matching criteria.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicDataPartitionRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic data partition rules")
+
+	rules, err := client.Logconfigurations.GetDataPartitionRulesWithContext(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rules == nil {
+		return diag.FromErr(fmt.Errorf("GetDataPartitionRules response was nil"))
+	}
+
+	var enabled *bool
+	if v, ok := d.GetOkExists("enabled"); ok {
+		e := v.(bool)
+		enabled = &e
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+	_ = d.Set("account_id", accountID)
+
+	return diag.FromErr(d.Set("rules", flattenDataPartitionRules(filterDataPartitionRules(*rules, enabled))))
+}
+
+// filterDataPartitionRules returns the rules that aren't deleted, optionally only those enabled or
+// disabled, sorted by target data partition and then by ID.
+func filterDataPartitionRules(rules []logconfigurations.LogConfigurationsDataPartitionRule, enabled *bool) []logconfigurations.LogConfigurationsDataPartitionRule {
+	filtered := []logconfigurations.LogConfigurationsDataPartitionRule{}
+
+	for _, r := range rules {
+		if r.Deleted || (enabled != nil && r.Enabled != *enabled) {
+			continue
+		}
+
+		filtered = append(filtered, r)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].TargetDataPartition != filtered[j].TargetDataPartition {
+			return filtered[i].TargetDataPartition < filtered[j].TargetDataPartition
+		}
+
+		return filtered[i].ID < filtered[j].ID
+	})
+
+	return filtered
+}
+
+func flattenDataPartitionRules(rules []logconfigurations.LogConfigurationsDataPartitionRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, len(rules))
+
+	for i, r := range rules {
+		flattened[i] = map[string]interface{}{
+			"id":                    r.ID,
+			"target_data_partition": string(r.TargetDataPartition),
+			"enabled":               r.Enabled,
+			"retention_policy":      string(r.RetentionPolicy),
+			"attribute_name":        r.MatchingCriteria.AttributeName,
+			"matching_expression":   r.MatchingCriteria.MatchingExpression,
+		}
+	}
+
+	return flattened
+}
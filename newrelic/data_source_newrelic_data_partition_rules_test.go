@@ -0,0 +1,120 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicDataPartitionRulesDataSource_Basic(t *testing.T) {
+	dataSourceName := "data.newrelic_data_partition_rules.all"
+	rName := acctest.RandString(7)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccLogDataPartitionsCleanup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicDataPartitionRuleDestroy,
+		Steps: []resource.TestStep{
+			// Test: Both rules are listed
+			{
+				Config: testAccNewRelicDataPartitionRulesDataSourceConfig(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRulesListed(dataSourceName, "newrelic_data_partition_rule.first", true),
+					testAccCheckNewRelicDataPartitionRulesListed(dataSourceName, "newrelic_data_partition_rule.second", true),
+				),
+			},
+			// Test: Only the disabled rule is listed
+			{
+				Config: testAccNewRelicDataPartitionRulesDataSourceConfig(rName, "enabled = false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRulesListed(dataSourceName, "newrelic_data_partition_rule.first", false),
+					testAccCheckNewRelicDataPartitionRulesListed(dataSourceName, "newrelic_data_partition_rule.second", true),
+				),
+			},
+		},
+	})
+}
+
+// Checks whether the rule is in the data source's list, and if so that its attributes match the resource.
+func testAccCheckNewRelicDataPartitionRulesListed(dataSourceName string, resourceName string, listed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		count, err := strconv.Atoi(ds.Primary.Attributes["rules.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("rules.%d.", i)
+			if ds.Primary.Attributes[prefix+"id"] != rs.Primary.ID {
+				continue
+			}
+
+			if !listed {
+				return fmt.Errorf("expected rule %s not to be listed", rs.Primary.ID)
+			}
+
+			for _, attr := range []string{"target_data_partition", "enabled", "retention_policy", "attribute_name", "matching_expression"} {
+				if ds.Primary.Attributes[prefix+attr] != rs.Primary.Attributes[attr] {
+					return fmt.Errorf("expected %s of rule %s to be %q, got %q", attr, rs.Primary.ID, rs.Primary.Attributes[attr], ds.Primary.Attributes[prefix+attr])
+				}
+			}
+
+			return nil
+		}
+
+		if listed {
+			return fmt.Errorf("expected rule %s to be listed", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNewRelicDataPartitionRulesDataSourceConfig(name string, filter string) string {
+	return fmt.Sprintf(`
+resource "newrelic_data_partition_rule" "first" {
+	account_id            = %[1]d
+	description           = "%[2]s first"
+	enabled               = true
+	nrql                  = "logtype='node'"
+	retention_policy      = "SECONDARY"
+	target_data_partition = "Log_Test_%[2]s_a"
+}
+
+resource "newrelic_data_partition_rule" "second" {
+	account_id            = %[1]d
+	description           = "%[2]s second"
+	enabled               = false
+	nrql                  = "logtype='nginx'"
+	retention_policy      = "STANDARD"
+	target_data_partition = "Log_Test_%[2]s_b"
+}
+
+data "newrelic_data_partition_rules" "all" {
+	account_id = %[1]d
+	%[3]s
+
+	depends_on = [
+		newrelic_data_partition_rule.first,
+		newrelic_data_partition_rule.second,
+	]
+}
+`, testAccountID, name, filter)
+}
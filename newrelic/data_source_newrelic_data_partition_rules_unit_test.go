@@ -0,0 +1,69 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/logconfigurations"
+	"github.com/stretchr/testify/require"
+)
+
+func testDataPartitionRuleIDs(rules []logconfigurations.LogConfigurationsDataPartitionRule) []string {
+	ids := make([]string, len(rules))
+	for i, r := range rules {
+		ids[i] = r.ID
+	}
+
+	return ids
+}
+
+func TestFilterDataPartitionRules(t *testing.T) {
+	t.Parallel()
+
+	enabled, disabled := true, false
+
+	require.Equal(t, []string{"2", "3", "4"}, testDataPartitionRuleIDs(filterDataPartitionRules(testDataPartitionRules, nil)))
+	require.Equal(t, []string{"2"}, testDataPartitionRuleIDs(filterDataPartitionRules(testDataPartitionRules, &enabled)))
+	require.Equal(t, []string{"3", "4"}, testDataPartitionRuleIDs(filterDataPartitionRules(testDataPartitionRules, &disabled)))
+	require.Empty(t, filterDataPartitionRules(nil, nil))
+}
+
+func TestFilterDataPartitionRules_SortedByTarget(t *testing.T) {
+	t.Parallel()
+
+	rules := []logconfigurations.LogConfigurationsDataPartitionRule{
+		{ID: "c", TargetDataPartition: "Log_Node"},
+		{ID: "b", TargetDataPartition: "Log_Apache"},
+		{ID: "a", TargetDataPartition: "Log_Node"},
+	}
+
+	require.Equal(t, []string{"b", "a", "c"}, testDataPartitionRuleIDs(filterDataPartitionRules(rules, nil)))
+}
+
+func TestFlattenDataPartitionRules(t *testing.T) {
+	t.Parallel()
+
+	d := dataSourceNewRelicDataPartitionRules().TestResourceData()
+	require.NoError(t, d.Set("rules", flattenDataPartitionRules([]logconfigurations.LogConfigurationsDataPartitionRule{
+		{
+			ID:                  "2",
+			TargetDataPartition: "Log_Nginx",
+			Enabled:             true,
+			RetentionPolicy:     logconfigurations.LogConfigurationsDataPartitionRuleRetentionPolicyTypeTypes.SECONDARY,
+			MatchingCriteria: logconfigurations.LogConfigurationsDataPartitionRuleMatchingCriteria{
+				AttributeName:      "logtype",
+				MatchingExpression: "'nginx'",
+			},
+		},
+	})))
+
+	require.Equal(t, 1, d.Get("rules.#"))
+	require.Equal(t, "2", d.Get("rules.0.id"))
+	require.Equal(t, "Log_Nginx", d.Get("rules.0.target_data_partition"))
+	require.Equal(t, true, d.Get("rules.0.enabled"))
+	require.Equal(t, "SECONDARY", d.Get("rules.0.retention_policy"))
+	require.Equal(t, "logtype", d.Get("rules.0.attribute_name"))
+	require.Equal(t, "'nginx'", d.Get("rules.0.matching_expression"))
+}
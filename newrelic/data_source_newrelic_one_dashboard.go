@@ -0,0 +1,112 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+func dataSourceNewRelicOneDashboard() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicOneDashboardRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The New Relic account ID where the dashboard exists.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the dashboard.",
+			},
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique entity identifier of the dashboard in New Relic.",
+			},
+			"permalink": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dashboard.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicOneDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic dashboards")
+
+	name := d.Get("name").(string)
+	query := fmt.Sprintf("type = 'DASHBOARD' AND accountId = %d AND name = '%s'", accountID, escapeSingleQuote(name))
+
+	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(
+		ctx,
+		entities.EntitySearchOptions{},
+		query,
+		[]entities.EntitySearchSortCriteria{},
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if entitySearch == nil {
+		return diag.FromErr(fmt.Errorf("GetEntitySearchByQuery response was nil"))
+	}
+
+	dashboard, err := findNewRelicOneDashboardByName(entitySearch.Results.Entities, name, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(dashboard.GUID))
+	_ = d.Set("account_id", dashboard.AccountID)
+	_ = d.Set("guid", string(dashboard.GUID))
+	_ = d.Set("permalink", dashboard.Permalink)
+
+	return nil
+}
+
+// findNewRelicOneDashboardByName returns the single dashboard in the given account with an
+// exact name match. Dashboard pages are indexed as separate entities, so only top-level
+// dashboards are considered. Multiple matches are reported as an error listing their GUIDs.
+func findNewRelicOneDashboardByName(results []entities.EntityOutlineInterface, name string, accountID int) (*entities.DashboardEntityOutline, error) {
+	var matches []*entities.DashboardEntityOutline
+
+	for _, e := range results {
+		dashboard, ok := e.(*entities.DashboardEntityOutline)
+		if !ok {
+			continue
+		}
+
+		if dashboard.DashboardParentGUID != "" || dashboard.AccountID != accountID || dashboard.Name != name {
+			continue
+		}
+
+		matches = append(matches, dashboard)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no dashboard found with name '%s' in account %d", name, accountID)
+	case 1:
+		return matches[0], nil
+	}
+
+	guids := make([]string, len(matches))
+	for i, m := range matches {
+		guids[i] = string(m.GUID)
+	}
+
+	return nil, fmt.Errorf("found %d dashboards with name '%s' in account %d, names must be unique: %s", len(matches), name, accountID, strings.Join(guids, ", "))
+}
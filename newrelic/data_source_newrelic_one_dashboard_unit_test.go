@@ -0,0 +1,69 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func testMockDashboardEntitySearchResults() []entities.EntityOutlineInterface {
+	return []entities.EntityOutlineInterface{
+		&entities.DashboardEntityOutline{
+			AccountID: 1,
+			GUID:      "MXxWSVp8REFTSEJPQVJEfDE",
+			Name:      "Production",
+			Permalink: "https://one.newrelic.com/redirect/entity/MXxWSVp8REFTSEJPQVJEfDE",
+		},
+		&entities.DashboardEntityOutline{
+			AccountID:           1,
+			GUID:                "MXxWSVp8REFTSEJPQVJEfDI",
+			Name:                "Production",
+			DashboardParentGUID: "MXxWSVp8REFTSEJPQVJEfDE",
+		},
+		&entities.DashboardEntityOutline{
+			AccountID: 2,
+			GUID:      "MnxWSVp8REFTSEJPQVJEfDM",
+			Name:      "Production",
+		},
+		&entities.DashboardEntityOutline{
+			AccountID: 1,
+			GUID:      "MXxWSVp8REFTSEJPQVJEfDQ",
+			Name:      "Staging",
+		},
+		&entities.DashboardEntityOutline{
+			AccountID: 1,
+			GUID:      "MXxWSVp8REFTSEJPQVJEfDU",
+			Name:      "Staging",
+		},
+	}
+}
+
+func TestFindNewRelicOneDashboardByName(t *testing.T) {
+	t.Parallel()
+
+	dashboard, err := findNewRelicOneDashboardByName(testMockDashboardEntitySearchResults(), "Production", 1)
+
+	require.NoError(t, err)
+	require.Equal(t, "MXxWSVp8REFTSEJPQVJEfDE", string(dashboard.GUID))
+	require.Equal(t, "https://one.newrelic.com/redirect/entity/MXxWSVp8REFTSEJPQVJEfDE", dashboard.Permalink)
+}
+
+func TestFindNewRelicOneDashboardByName_NotFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := findNewRelicOneDashboardByName(testMockDashboardEntitySearchResults(), "Development", 1)
+
+	require.EqualError(t, err, "no dashboard found with name 'Development' in account 1")
+}
+
+func TestFindNewRelicOneDashboardByName_Duplicates(t *testing.T) {
+	t.Parallel()
+
+	_, err := findNewRelicOneDashboardByName(testMockDashboardEntitySearchResults(), "Staging", 1)
+
+	require.EqualError(t, err, "found 2 dashboards with name 'Staging' in account 1, names must be unique: MXxWSVp8REFTSEJPQVJEfDQ, MXxWSVp8REFTSEJPQVJEfDU")
+}
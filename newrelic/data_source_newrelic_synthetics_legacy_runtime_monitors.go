@@ -0,0 +1,172 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+// The maximum number of entities that can be fetched by GUID in a single request.
+const syntheticsLegacyRuntimeMonitorsBatchSize = 25
+
+func dataSourceNewRelicSyntheticsLegacyRuntimeMonitors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsLegacyRuntimeMonitorsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account in which to look for monitors on the legacy runtime.",
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The monitors that still run on the legacy runtime, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GUID of the monitor.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the monitor.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the monitor.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsLegacyRuntimeMonitorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic Synthetics monitors on the legacy runtime")
+
+	monitors, err := findSyntheticsLegacyRuntimeMonitors(ctx, client, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+	_ = d.Set("account_id", accountID)
+
+	return diag.FromErr(d.Set("monitors", flattenSyntheticsLegacyRuntimeMonitors(monitors)))
+}
+
+// Returns the monitors of the account that run on the legacy runtime. Entity search does not return
+// the tags holding the runtime of a monitor, so the runtime based monitors it finds are fetched again
+// by GUID.
+func findSyntheticsLegacyRuntimeMonitors(ctx context.Context, client *newrelic.NewRelic, accountID int) ([]*entities.SyntheticMonitorEntity, error) {
+	query := fmt.Sprintf("domain = 'SYNTH' AND type = 'MONITOR' AND accountId = %d", accountID)
+
+	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(ctx, entities.EntitySearchOptions{}, query, []entities.EntitySearchSortCriteria{})
+	if err != nil {
+		return nil, err
+	}
+
+	if entitySearch == nil {
+		return nil, fmt.Errorf("GetEntitySearchByQuery response was nil")
+	}
+
+	guids := []common.EntityGUID{}
+	for _, e := range entitySearch.Results.Entities {
+		if m, ok := e.(*entities.SyntheticMonitorEntityOutline); ok {
+			if _, ok := syntheticsMonitorRuntimeMigrations[string(m.MonitorType)]; ok {
+				guids = append(guids, m.GUID)
+			}
+		}
+	}
+
+	results := []entities.EntityInterface{}
+	for start := 0; start < len(guids); start += syntheticsLegacyRuntimeMonitorsBatchSize {
+		end := start + syntheticsLegacyRuntimeMonitorsBatchSize
+		if end > len(guids) {
+			end = len(guids)
+		}
+
+		resp, err := client.Entities.GetEntitiesWithContext(ctx, guids[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, *resp...)
+	}
+
+	return filterSyntheticsLegacyRuntimeMonitors(results), nil
+}
+
+// filterSyntheticsLegacyRuntimeMonitors returns the runtime based monitors that have no runtime type,
+// sorted by name and then by GUID.
+func filterSyntheticsLegacyRuntimeMonitors(results []entities.EntityInterface) []*entities.SyntheticMonitorEntity {
+	monitors := []*entities.SyntheticMonitorEntity{}
+
+	for _, e := range results {
+		m, ok := e.(*entities.SyntheticMonitorEntity)
+		if !ok {
+			continue
+		}
+
+		if _, ok := syntheticsMonitorRuntimeMigrations[string(m.MonitorType)]; !ok {
+			continue
+		}
+
+		if syntheticsMonitorRuntimeType(m.Tags) == "" {
+			monitors = append(monitors, m)
+		}
+	}
+
+	sort.SliceStable(monitors, func(i, j int) bool {
+		if monitors[i].Name != monitors[j].Name {
+			return monitors[i].Name < monitors[j].Name
+		}
+
+		return monitors[i].GUID < monitors[j].GUID
+	})
+
+	return monitors
+}
+
+// Returns the value of the runtimeType tag of a monitor, empty for monitors on the legacy runtime.
+func syntheticsMonitorRuntimeType(tags []entities.EntityTag) string {
+	for _, t := range tags {
+		if t.Key == "runtimeType" && len(t.Values) > 0 {
+			return t.Values[0]
+		}
+	}
+
+	return ""
+}
+
+func flattenSyntheticsLegacyRuntimeMonitors(monitors []*entities.SyntheticMonitorEntity) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, len(monitors))
+
+	for i, m := range monitors {
+		flattened[i] = map[string]interface{}{
+			"guid": string(m.GUID),
+			"name": m.Name,
+			"type": string(m.MonitorType),
+		}
+	}
+
+	return flattened
+}
@@ -0,0 +1,118 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicSyntheticsLegacyRuntimeMonitorsDataSource_Basic(t *testing.T) {
+	dataSourceName := "data.newrelic_synthetics_legacy_runtime_monitors.legacy"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsScriptMonitorDestroy,
+		Steps: []resource.TestStep{
+			// Create the monitors
+			{
+				Config: testAccNewRelicSyntheticsLegacyRuntimeMonitorsConfig(rName),
+			},
+			// Test: Only the monitor on the legacy runtime is listed
+			{
+				PreConfig: func() {
+					// Allow the monitor entities to be indexed
+					time.Sleep(30 * time.Second)
+				},
+				Config: testAccNewRelicSyntheticsLegacyRuntimeMonitorsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_id", strconv.Itoa(testAccountID)),
+					testAccCheckNewRelicSyntheticsLegacyRuntimeMonitorListed(dataSourceName, "newrelic_synthetics_script_monitor.legacy", true),
+					testAccCheckNewRelicSyntheticsLegacyRuntimeMonitorListed(dataSourceName, "newrelic_synthetics_script_monitor.current", false),
+				),
+			},
+		},
+	})
+}
+
+// Checks whether the monitor's GUID is in the data source's list of monitors.
+func testAccCheckNewRelicSyntheticsLegacyRuntimeMonitorListed(dataSourceName string, resourceName string, listed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		count, err := strconv.Atoi(ds.Primary.Attributes["monitors.#"])
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for i := 0; i < count; i++ {
+			if ds.Primary.Attributes[fmt.Sprintf("monitors.%d.guid", i)] == rs.Primary.ID {
+				found = true
+				break
+			}
+		}
+
+		if found != listed {
+			return fmt.Errorf("expected monitor %s listed to be %t, got %t", rs.Primary.ID, listed, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccNewRelicSyntheticsLegacyRuntimeMonitorsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_script_monitor" "legacy" {
+	name             = "%[1]s-legacy"
+	type             = "SCRIPT_API"
+	locations_public = ["US_WEST_1"]
+	period           = "EVERY_HOUR"
+	status           = "ENABLED"
+	script           = "console.log('terraform integration test')"
+}
+
+resource "newrelic_synthetics_script_monitor" "current" {
+	name                 = "%[1]s-current"
+	type                 = "SCRIPT_API"
+	locations_public     = ["US_WEST_1"]
+	period               = "EVERY_HOUR"
+	status               = "ENABLED"
+	script               = "console.log('terraform integration test')"
+	script_language      = "JAVASCRIPT"
+	runtime_type         = "NODE_API"
+	runtime_type_version = "16.10"
+}
+`, name)
+}
+
+func testAccNewRelicSyntheticsLegacyRuntimeMonitorsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "newrelic_synthetics_legacy_runtime_monitors" "legacy" {
+	account_id = %[2]d
+
+	depends_on = [
+		newrelic_synthetics_script_monitor.legacy,
+		newrelic_synthetics_script_monitor.current,
+	]
+}
+`, testAccNewRelicSyntheticsLegacyRuntimeMonitorsConfig(name), testAccountID)
+}
@@ -0,0 +1,104 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock of the monitors of an account, one of each type on the legacy runtime and on the new runtime.
+var testSyntheticsLegacyRuntimeMonitors = []struct {
+	guid        string
+	name        string
+	monitorType string
+	runtimeType string
+}{
+	{"MXxTWU5USHxNT05JVE9SfDE", "checkout-api", "SCRIPT_API", ""},
+	{"MXxTWU5USHxNT05JVE9SfDI", "checkout-api-v2", "SCRIPT_API", "NODE_API"},
+	{"MXxTWU5USHxNT05JVE9SfDM", "login-flow", "SCRIPT_BROWSER", ""},
+	{"MXxTWU5USHxNT05JVE9SfDQ", "homepage", "BROWSER", "CHROME_BROWSER"},
+	{"MXxTWU5USHxNT05JVE9SfDU", "api-ping", "SIMPLE", ""},
+	{"MXxTWU5USHxNT05JVE9SfDY", "account-page", "BROWSER", ""},
+}
+
+func testMockSyntheticsLegacyRuntimeMonitorsProviderConfig(t *testing.T) *ProviderConfig {
+	return testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				GUIDs []string `json:"guids"`
+			} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		if strings.Contains(body.Query, "entitySearch") {
+			outlines := []map[string]interface{}{}
+			for _, m := range testSyntheticsLegacyRuntimeMonitors {
+				outlines = append(outlines, map[string]interface{}{
+					"__typename":  "SyntheticMonitorEntityOutline",
+					"accountId":   1,
+					"guid":        m.guid,
+					"name":        m.name,
+					"monitorType": m.monitorType,
+				})
+			}
+
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"actor": map[string]interface{}{"entitySearch": map[string]interface{}{
+					"results": map[string]interface{}{"entities": outlines},
+				}}},
+			}))
+			return
+		}
+
+		found := []map[string]interface{}{}
+		for _, m := range testSyntheticsLegacyRuntimeMonitors {
+			for _, guid := range body.Variables.GUIDs {
+				if guid != m.guid {
+					continue
+				}
+
+				tags := []map[string]interface{}{{"key": "monitorStatus", "values": []string{"Enabled"}}}
+				if m.runtimeType != "" {
+					tags = append(tags, map[string]interface{}{"key": "runtimeType", "values": []string{m.runtimeType}})
+				}
+
+				found = append(found, map[string]interface{}{
+					"__typename":  "SyntheticMonitorEntity",
+					"accountId":   1,
+					"guid":        m.guid,
+					"name":        m.name,
+					"monitorType": m.monitorType,
+					"tags":        tags,
+				})
+			}
+		}
+
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"actor": map[string]interface{}{"entities": found}},
+		}))
+	})
+}
+
+func TestSyntheticsLegacyRuntimeMonitorsRead(t *testing.T) {
+	t.Parallel()
+
+	d := dataSourceNewRelicSyntheticsLegacyRuntimeMonitors().TestResourceData()
+	diags := dataSourceNewRelicSyntheticsLegacyRuntimeMonitorsRead(context.Background(), d, testMockSyntheticsLegacyRuntimeMonitorsProviderConfig(t))
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "1", d.Id())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"guid": "MXxTWU5USHxNT05JVE9SfDY", "name": "account-page", "type": "BROWSER"},
+		map[string]interface{}{"guid": "MXxTWU5USHxNT05JVE9SfDE", "name": "checkout-api", "type": "SCRIPT_API"},
+		map[string]interface{}{"guid": "MXxTWU5USHxNT05JVE9SfDM", "name": "login-flow", "type": "SCRIPT_BROWSER"},
+	}, d.Get("monitors"))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,16 @@ func dataSourceNewRelicSyntheticsPrivateLocation() *schema.Resource {
 				Required:    true,
 				Description: "The name of the Synthetics monitor private location.",
 			},
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity GUID of the private location.",
+			},
+			"domain_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private location globally unique identifier.",
+			},
 		},
 	}
 }
@@ -53,21 +64,9 @@ func dataSourceNewRelicSyntheticsPrivateLocationRead(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	privateLocations := entitySearch.Results.Entities
-	var location *entities.GenericEntityOutline
-	for _, l := range privateLocations {
-		loc := l.(*entities.GenericEntityOutline)
-
-		// It's possible to have multiple private locations with the same name.
-		// Return the first matching private location.
-		if loc.AccountID == accountID && loc.Name == name {
-			location = loc
-			break
-		}
-	}
-
-	if location == nil {
-		return diag.FromErr(fmt.Errorf("no matches found for private location with name '%s'", name))
+	location, err := findSyntheticsPrivateLocationByName(entitySearch.Results.Entities, name.(string), accountID)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(string(location.GUID))
@@ -77,5 +76,44 @@ func dataSourceNewRelicSyntheticsPrivateLocationRead(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	return nil
+	err = d.Set("guid", string(location.GUID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domainID, ok := parseSyntheticsPrivateLocationGUID(string(location.GUID))
+	if !ok {
+		return diag.Errorf("private location '%s' has an unexpected GUID: %s", location.Name, location.GUID)
+	}
+
+	return diag.FromErr(d.Set("domain_id", domainID))
+}
+
+// findSyntheticsPrivateLocationByName returns the single private location of the account with exactly
+// the given name. Multiple matches are reported as an error listing their GUIDs.
+func findSyntheticsPrivateLocationByName(results []entities.EntityOutlineInterface, name string, accountID int) (*entities.GenericEntityOutline, error) {
+	var matches []*entities.GenericEntityOutline
+
+	for _, e := range results {
+		location, ok := e.(*entities.GenericEntityOutline)
+		if !ok || location.AccountID != accountID || location.Name != name {
+			continue
+		}
+
+		matches = append(matches, location)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no matches found for private location with name '%s'", name)
+	case 1:
+		return matches[0], nil
+	}
+
+	guids := make([]string, len(matches))
+	for i, m := range matches {
+		guids[i] = string(m.GUID)
+	}
+
+	return nil, fmt.Errorf("found %d private locations with name '%s' in account %d, names must be unique: %s", len(matches), name, accountID, strings.Join(guids, ", "))
 }
@@ -24,6 +24,7 @@ func TestAccNewRelicSyntheticsPrivateLocationDataSource_Basic(t *testing.T) {
 	}
 
 	var privateLocationGUID synthetics.EntityGUID
+	var privateLocationDomainID string
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -40,6 +41,7 @@ func TestAccNewRelicSyntheticsPrivateLocationDataSource_Basic(t *testing.T) {
 			}
 
 			privateLocationGUID = result.GUID
+			privateLocationDomainID = result.DomainId
 
 			// Workaround for async entity creation so we can test the data source below
 			time.Sleep(60 * time.Second)
@@ -50,6 +52,8 @@ func TestAccNewRelicSyntheticsPrivateLocationDataSource_Basic(t *testing.T) {
 				Config: testConfigDataSourceSyntheticsLocation(privateLocationName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccNewRelicSyntheticsLocationDataSource("data.newrelic_synthetics_private_location.bar", "name"),
+					resource.TestCheckResourceAttrPtr("data.newrelic_synthetics_private_location.bar", "guid", (*string)(&privateLocationGUID)),
+					resource.TestCheckResourceAttrPtr("data.newrelic_synthetics_private_location.bar", "domain_id", &privateLocationDomainID),
 				),
 			},
 		},
@@ -0,0 +1,36 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSyntheticsPrivateLocationByName(t *testing.T) {
+	results := []entities.EntityOutlineInterface{
+		&entities.GenericEntityOutline{AccountID: 1, GUID: "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ", Name: "datacenter"},
+		&entities.GenericEntityOutline{AccountID: 2, GUID: "MnxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDU2Nzg", Name: "datacenter"},
+		&entities.GenericEntityOutline{AccountID: 1, GUID: "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDU2Nzg", Name: "datacenter-2"},
+	}
+
+	location, err := findSyntheticsPrivateLocationByName(results, "datacenter", 1)
+	require.NoError(t, err)
+	require.Equal(t, "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ", string(location.GUID))
+
+	_, err = findSyntheticsPrivateLocationByName(results, "office", 1)
+	require.EqualError(t, err, "no matches found for private location with name 'office'")
+}
+
+func TestFindSyntheticsPrivateLocationByName_MultipleMatches(t *testing.T) {
+	results := []entities.EntityOutlineInterface{
+		&entities.GenericEntityOutline{AccountID: 1, GUID: "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ", Name: "datacenter"},
+		&entities.GenericEntityOutline{AccountID: 1, GUID: "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDU2Nzg", Name: "datacenter"},
+	}
+
+	_, err := findSyntheticsPrivateLocationByName(results, "datacenter", 1)
+	require.EqualError(t, err, "found 2 private locations with name 'datacenter' in account 1, names must be unique: MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ, MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDU2Nzg")
+}
@@ -0,0 +1,138 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+)
+
+func dataSourceNewRelicWorkflow() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicWorkflowRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account id of the workflow.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the workflow.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the workflow is enabled.",
+			},
+			"muting_rules_handling": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How to handle muted issues.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	updatedContext := updateContextWithAccountID(ctx, accountID)
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Reading New Relic workflow %s", name)
+
+	// The client's workflows query can neither filter by name nor page through results, so the
+	// name filter and cursor are passed in a query of our own.
+	var results []workflows.AiWorkflowsWorkflow
+	vars := map[string]interface{}{
+		"accountID": accountID,
+		"filters":   workflows.AiWorkflowsFilters{Name: name},
+	}
+
+	for {
+		resp := workflowsByNameResponse{}
+		if err := client.NerdGraph.QueryWithResponseAndContext(updatedContext, workflowsByNameQuery, vars, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+
+		page := resp.Actor.Account.AiWorkflows.Workflows
+		results = append(results, page.Entities...)
+
+		if page.NextCursor == "" {
+			break
+		}
+		vars["cursor"] = page.NextCursor
+	}
+
+	workflow, err := findWorkflowByName(results, name, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workflow.ID)
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("name", workflow.Name)
+	_ = d.Set("enabled", workflow.WorkflowEnabled)
+	_ = d.Set("muting_rules_handling", string(workflow.MutingRulesHandling))
+
+	return nil
+}
+
+// findWorkflowByName returns the single workflow with exactly the given name. Multiple matches are
+// reported as an error listing their IDs.
+func findWorkflowByName(results []workflows.AiWorkflowsWorkflow, name string, accountID int) (*workflows.AiWorkflowsWorkflow, error) {
+	var matches []workflows.AiWorkflowsWorkflow
+
+	for _, w := range results {
+		if w.Name == name {
+			matches = append(matches, w)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no workflow found with name '%s' in account %d", name, accountID)
+	case 1:
+		return &matches[0], nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+
+	return nil, fmt.Errorf("found %d workflows with name '%s' in account %d, names must be unique: %s", len(matches), name, accountID, strings.Join(ids, ", "))
+}
+
+type workflowsByNameResponse struct {
+	Actor struct {
+		Account struct {
+			AiWorkflows struct {
+				Workflows workflows.AiWorkflowsWorkflows `json:"workflows"`
+			} `json:"aiWorkflows"`
+		} `json:"account"`
+	} `json:"actor"`
+}
+
+const workflowsByNameQuery = `query(
+	$accountID: Int!,
+	$cursor: String,
+	$filters: AiWorkflowsFilters,
+) { actor { account(id: $accountID) { aiWorkflows { workflows(cursor: $cursor, filters: $filters) {
+	entities {
+		accountId
+		id
+		mutingRulesHandling
+		name
+		workflowEnabled
+	}
+	nextCursor
+} } } } }`
@@ -0,0 +1,45 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicWorkflowDataSource_Basic(t *testing.T) {
+	resourceName := "newrelic_workflow.foo"
+	dataSourceName := "data.newrelic_workflow.workflow"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicWorkflowDestroy,
+		Steps: []resource.TestStep{
+			// Test: Read
+			{
+				Config: testAccNewRelicWorkflowDataSourceConfig(testAccountID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enabled", resourceName, "enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "muting_rules_handling", "NOTIFY_ALL_ISSUES"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicWorkflowDataSourceConfig(accountID int, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "newrelic_workflow" "workflow" {
+  account_id = %[2]d
+  name       = newrelic_workflow.foo.name
+}
+`, testAccNewRelicWorkflowConfigurationMinimal(accountID, name), accountID)
+}
@@ -0,0 +1,107 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMockWorkflowsProviderConfig serves the given pages of workflows, chained by their cursor.
+func testMockWorkflowsProviderConfig(t *testing.T, pages ...string) *ProviderConfig {
+	return testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				AccountID int    `json:"accountID"`
+				Cursor    string `json:"cursor"`
+				Filters   struct {
+					Name string `json:"name"`
+				} `json:"filters"`
+			} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, 1, body.Variables.AccountID)
+		assert.Equal(t, "checkout", body.Variables.Filters.Name)
+
+		page := 0
+		if body.Variables.Cursor != "" {
+			_, err := fmt.Sscanf(body.Variables.Cursor, "page-%d", &page)
+			assert.NoError(t, err)
+		}
+
+		nextCursor := ""
+		if page+1 < len(pages) {
+			nextCursor = fmt.Sprintf("page-%d", page+1)
+		}
+
+		_, _ = fmt.Fprintf(w, `{"data":{"actor":{"account":{"aiWorkflows":{"workflows":{"entities":[%s],"nextCursor":%q}}}}}}`, pages[page], nextCursor)
+	})
+}
+
+func testDataSourceNewRelicWorkflowRead(t *testing.T, meta *ProviderConfig) (map[string]interface{}, error) {
+	d := dataSourceNewRelicWorkflow().TestResourceData()
+	require.NoError(t, d.Set("name", "checkout"))
+
+	diags := dataSourceNewRelicWorkflowRead(context.Background(), d, meta)
+	if diags.HasError() {
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	return map[string]interface{}{
+		"id":                    d.Id(),
+		"account_id":            d.Get("account_id"),
+		"enabled":               d.Get("enabled"),
+		"muting_rules_handling": d.Get("muting_rules_handling"),
+	}, nil
+}
+
+func TestDataSourceNewRelicWorkflowRead(t *testing.T) {
+	t.Parallel()
+
+	meta := testMockWorkflowsProviderConfig(t,
+		`{"accountId":1,"id":"5e1ac7c3-d9ea-4b7f-a66c-9ab0c9ddd81b","name":"checkout-legacy","workflowEnabled":true,"mutingRulesHandling":"NOTIFY_ALL_ISSUES"}`,
+		`{"accountId":1,"id":"0b3b3c4c-2ea1-4a28-8d32-ff8cd0d5bd2e","name":"checkout","workflowEnabled":false,"mutingRulesHandling":"DONT_NOTIFY_FULLY_MUTED_ISSUES"}`,
+	)
+
+	attrs, err := testDataSourceNewRelicWorkflowRead(t, meta)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]interface{}{
+		"id":                    "0b3b3c4c-2ea1-4a28-8d32-ff8cd0d5bd2e",
+		"account_id":            1,
+		"enabled":               false,
+		"muting_rules_handling": "DONT_NOTIFY_FULLY_MUTED_ISSUES",
+	}, attrs)
+}
+
+func TestDataSourceNewRelicWorkflowRead_DuplicateNames(t *testing.T) {
+	t.Parallel()
+
+	meta := testMockWorkflowsProviderConfig(t,
+		`{"accountId":1,"id":"5e1ac7c3-d9ea-4b7f-a66c-9ab0c9ddd81b","name":"checkout"}`,
+		`{"accountId":1,"id":"0b3b3c4c-2ea1-4a28-8d32-ff8cd0d5bd2e","name":"checkout"}`,
+	)
+
+	_, err := testDataSourceNewRelicWorkflowRead(t, meta)
+	require.EqualError(t, err, "found 2 workflows with name 'checkout' in account 1, names must be unique: 5e1ac7c3-d9ea-4b7f-a66c-9ab0c9ddd81b, 0b3b3c4c-2ea1-4a28-8d32-ff8cd0d5bd2e")
+}
+
+func TestFindWorkflowByName_NotFound(t *testing.T) {
+	t.Parallel()
+
+	results := []workflows.AiWorkflowsWorkflow{
+		{ID: "5e1ac7c3-d9ea-4b7f-a66c-9ab0c9ddd81b", Name: "checkout-legacy"},
+	}
+
+	_, err := findWorkflowByName(results, "checkout", 1)
+	require.EqualError(t, err, "no workflow found with name 'checkout' in account 1")
+}
@@ -0,0 +1,165 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+func dataSourceNewRelicWorkload() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicWorkloadRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The New Relic account ID where the workload exists.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "guid"},
+				Description:  "The name of the workload.",
+			},
+			"guid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "guid"},
+				Description:  "The unique entity identifier of the workload in New Relic.",
+			},
+			"workload_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The unique ID of the workload.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the workload, one of OPERATIONAL, DEGRADED, DISRUPTED or UNKNOWN.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicWorkloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic workloads")
+
+	name := d.Get("name").(string)
+	guid := d.Get("guid").(string)
+
+	query := fmt.Sprintf("type = 'WORKLOAD' AND accountId = %d AND name = '%s'", accountID, escapeSingleQuote(name))
+	if guid != "" {
+		query = fmt.Sprintf("type = 'WORKLOAD' AND id = '%s'", escapeSingleQuote(guid))
+	}
+
+	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(
+		ctx,
+		entities.EntitySearchOptions{},
+		query,
+		[]entities.EntitySearchSortCriteria{},
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if entitySearch == nil {
+		return diag.FromErr(fmt.Errorf("GetEntitySearchByQuery response was nil"))
+	}
+
+	workload, err := findNewRelicWorkload(entitySearch.Results.Entities, name, guid, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenWorkloadEntityOutline(workload, d))
+}
+
+// findNewRelicWorkload returns the single workload matching the given GUID or, when no GUID is
+// given, the single workload in the given account with an exact name match. Multiple name
+// matches are reported as an error listing their GUIDs.
+func findNewRelicWorkload(results []entities.EntityOutlineInterface, name string, guid string, accountID int) (*entities.WorkloadEntityOutline, error) {
+	var matches []*entities.WorkloadEntityOutline
+
+	for _, e := range results {
+		workload, ok := e.(*entities.WorkloadEntityOutline)
+		if !ok {
+			continue
+		}
+
+		if guid != "" {
+			if string(workload.GUID) == guid {
+				return workload, nil
+			}
+			continue
+		}
+
+		if workload.AccountID != accountID || workload.Name != name {
+			continue
+		}
+
+		matches = append(matches, workload)
+	}
+
+	if guid != "" {
+		return nil, fmt.Errorf("no workload found with guid '%s'", guid)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no workload found with name '%s' in account %d", name, accountID)
+	case 1:
+		return matches[0], nil
+	}
+
+	guids := make([]string, len(matches))
+	for i, m := range matches {
+		guids[i] = string(m.GUID)
+	}
+
+	return nil, fmt.Errorf("found %d workloads with name '%s' in account %d, names must be unique: %s", len(matches), name, accountID, strings.Join(guids, ", "))
+}
+
+func flattenWorkloadEntityOutline(workload *entities.WorkloadEntityOutline, d *schema.ResourceData) error {
+	workloadID, err := parseWorkloadEntityGUID(string(workload.GUID))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(string(workload.GUID))
+	_ = d.Set("account_id", workload.AccountID)
+	_ = d.Set("name", workload.Name)
+	_ = d.Set("guid", string(workload.GUID))
+	_ = d.Set("workload_id", workloadID)
+	_ = d.Set("status", string(workload.WorkloadStatus.StatusValue))
+
+	return nil
+}
+
+// Returns the workload ID encoded in a workload entity GUID (`<accountID>|NR1|WORKLOAD|<workloadID>`).
+func parseWorkloadEntityGUID(guid string) (int, error) {
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return 0, fmt.Errorf("invalid workload guid '%s': %w", guid, err)
+	}
+
+	parts := strings.Split(string(decoded), "|")
+	if len(parts) != 4 || parts[2] != "WORKLOAD" {
+		return 0, fmt.Errorf("invalid workload guid '%s'", guid)
+	}
+
+	return strconv.Atoi(parts[3])
+}
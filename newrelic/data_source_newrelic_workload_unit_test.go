@@ -0,0 +1,115 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock of the results returned by the workload entity search.
+const testWorkloadEntitySearchResponse = `{
+	"entities": [
+		{
+			"__typename": "WorkloadEntityOutline",
+			"accountId": 1,
+			"guid": "MXxOUjF8V09SS0xPQUR8NDI",
+			"name": "Checkout",
+			"workloadStatus": {"statusValue": "DEGRADED", "statusSource": "ROLLUP_RULE"}
+		},
+		{
+			"__typename": "WorkloadEntityOutline",
+			"accountId": 2,
+			"guid": "MnxOUjF8V09SS0xPQUR8NDU",
+			"name": "Checkout",
+			"workloadStatus": {"statusValue": "OPERATIONAL"}
+		},
+		{
+			"__typename": "WorkloadEntityOutline",
+			"accountId": 1,
+			"guid": "MXxOUjF8V09SS0xPQUR8NDM",
+			"name": "Payments",
+			"workloadStatus": {"statusValue": "OPERATIONAL"}
+		},
+		{
+			"__typename": "WorkloadEntityOutline",
+			"accountId": 1,
+			"guid": "MXxOUjF8V09SS0xPQUR8NDQ",
+			"name": "Payments",
+			"workloadStatus": {"statusValue": "UNKNOWN"}
+		}
+	]
+}`
+
+func testMockWorkloadEntitySearchResults(t *testing.T) []entities.EntityOutlineInterface {
+	var results entities.EntitySearchResult
+	require.NoError(t, json.Unmarshal([]byte(testWorkloadEntitySearchResponse), &results))
+	require.Len(t, results.Entities, 4)
+
+	return results.Entities
+}
+
+func TestFindNewRelicWorkload_ByName(t *testing.T) {
+	t.Parallel()
+
+	workload, err := findNewRelicWorkload(testMockWorkloadEntitySearchResults(t), "Checkout", "", 1)
+
+	require.NoError(t, err)
+	require.Equal(t, "MXxOUjF8V09SS0xPQUR8NDI", string(workload.GUID))
+}
+
+func TestFindNewRelicWorkload_ByGUID(t *testing.T) {
+	t.Parallel()
+
+	workload, err := findNewRelicWorkload(testMockWorkloadEntitySearchResults(t), "", "MnxOUjF8V09SS0xPQUR8NDU", 1)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, workload.AccountID)
+}
+
+func TestFindNewRelicWorkload_NotFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := findNewRelicWorkload(testMockWorkloadEntitySearchResults(t), "Shipping", "", 1)
+	require.EqualError(t, err, "no workload found with name 'Shipping' in account 1")
+
+	_, err = findNewRelicWorkload(testMockWorkloadEntitySearchResults(t), "", "MXxOUjF8V09SS0xPQUR8OTk", 1)
+	require.EqualError(t, err, "no workload found with guid 'MXxOUjF8V09SS0xPQUR8OTk'")
+}
+
+func TestFindNewRelicWorkload_MultipleMatches(t *testing.T) {
+	t.Parallel()
+
+	_, err := findNewRelicWorkload(testMockWorkloadEntitySearchResults(t), "Payments", "", 1)
+
+	require.EqualError(t, err, "found 2 workloads with name 'Payments' in account 1, names must be unique: MXxOUjF8V09SS0xPQUR8NDM, MXxOUjF8V09SS0xPQUR8NDQ")
+}
+
+func TestFlattenWorkloadEntityOutline(t *testing.T) {
+	t.Parallel()
+
+	workload, err := findNewRelicWorkload(testMockWorkloadEntitySearchResults(t), "Checkout", "", 1)
+	require.NoError(t, err)
+
+	d := dataSourceNewRelicWorkload().TestResourceData()
+	require.NoError(t, flattenWorkloadEntityOutline(workload, d))
+
+	require.Equal(t, "MXxOUjF8V09SS0xPQUR8NDI", d.Id())
+	require.Equal(t, 1, d.Get("account_id"))
+	require.Equal(t, "Checkout", d.Get("name"))
+	require.Equal(t, "MXxOUjF8V09SS0xPQUR8NDI", d.Get("guid"))
+	require.Equal(t, 42, d.Get("workload_id"))
+	require.Equal(t, "DEGRADED", d.Get("status"))
+}
+
+func TestParseWorkloadEntityGUID_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseWorkloadEntityGUID("MXxWSVp8REFTSEJPQVJEfDE")
+
+	require.EqualError(t, err, "invalid workload guid 'MXxWSVp8REFTSEJPQVJEfDE'")
+}
@@ -3,14 +3,17 @@ package newrelic
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/contextkeys"
 )
@@ -35,9 +38,10 @@ func parseIDs(serializedID string, count int) ([]int, error) {
 	return ids, nil
 }
 
+// Splits an import ID of the form "x:y", such as "<accountID>:<resourceID>", into its two parts.
 func parseCompositeID(id string) (p1 string, p2 string, err error) {
 	parts := strings.SplitN(id, ":", 2)
-	if len(parts) == 2 {
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
 		p1 = parts[0]
 		p2 = parts[1]
 	} else {
@@ -159,3 +163,100 @@ func revertEscapedSingleQuote(name string) string {
 
 	return name
 }
+
+// graphqlError is an entry of the errors of a NerdGraph response.
+type graphqlError struct {
+	Message    string
+	Path       []string
+	ErrorClass string
+}
+
+// graphqlErrorResponse is implemented by the GraphQL error response returned by the client. Its type
+// is internal to the client, so it is matched by its methods and its errors are looked up by name.
+type graphqlErrorResponse interface {
+	error
+	IsRetryableError() bool
+	IsDeprecated() bool
+}
+
+// graphqlErrorsFromErr returns the GraphQL errors held by an error returned by the client.
+func graphqlErrorsFromErr(err error) []graphqlError {
+	var res graphqlErrorResponse
+	if !errors.As(err, &res) {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(res))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	errs := v.FieldByName("Errors")
+	if errs.Kind() != reflect.Slice {
+		return nil
+	}
+
+	result := make([]graphqlError, 0, errs.Len())
+	for i := 0; i < errs.Len(); i++ {
+		e := errs.Index(i)
+		if e.Kind() != reflect.Struct {
+			return nil
+		}
+
+		ge := graphqlError{}
+		if message := e.FieldByName("Message"); message.Kind() == reflect.String {
+			ge.Message = message.String()
+		}
+		if path, ok := e.FieldByName("Path").Interface().([]string); ok {
+			ge.Path = path
+		}
+		if extensions := e.FieldByName("Extensions"); extensions.Kind() == reflect.Struct {
+			if errorClass := extensions.FieldByName("ErrorClass"); errorClass.Kind() == reflect.String {
+				ge.ErrorClass = errorClass.String()
+			}
+		}
+
+		result = append(result, ge)
+	}
+
+	return result
+}
+
+// graphqlErrorsToDiags builds a diagnostic for each GraphQL error, with the path and the error class of
+// the error in the detail.
+func graphqlErrorsToDiags(errs []graphqlError) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range errs {
+		details := []string{}
+
+		if len(e.Path) > 0 {
+			details = append(details, "path: "+strings.Join(e.Path, "."))
+		}
+
+		if e.ErrorClass != "" {
+			details = append(details, "errorClass: "+e.ErrorClass)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  e.Message,
+			Detail:   strings.Join(details, "\n"),
+		})
+	}
+
+	return diags
+}
+
+// diagFromGraphQLErr is diag.FromErr, with a diagnostic per GraphQL error when the error holds any.
+func diagFromGraphQLErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	if errs := graphqlErrorsFromErr(err); len(errs) > 0 {
+		return graphqlErrorsToDiags(errs)
+	}
+
+	return diag.FromErr(err)
+}
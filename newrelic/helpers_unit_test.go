@@ -4,8 +4,10 @@
 package newrelic
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +29,26 @@ func TestParseIDs_BadIDs(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestParseCompositeID(t *testing.T) {
+	p1, p2, err := parseCompositeID("12345:abc-def")
+	require.NoError(t, err)
+	require.Equal(t, "12345", p1)
+	require.Equal(t, "abc-def", p2)
+
+	// Only the first colon separates the parts.
+	p1, p2, err = parseCompositeID("12345:abc:def")
+	require.NoError(t, err)
+	require.Equal(t, "12345", p1)
+	require.Equal(t, "abc:def", p2)
+}
+
+func TestParseCompositeID_Invalid(t *testing.T) {
+	for _, id := range []string{"", "abc-def", ":abc-def", "12345:", ":"} {
+		_, _, err := parseCompositeID(id)
+		require.EqualError(t, err, "error: Import composite ID requires two parts separated by colon, eg x:y", id)
+	}
+}
+
 func TestParseHashedIDs_Basic(t *testing.T) {
 	expected := []int{1, 2, 3}
 	result, err := parseHashedIDs("1:2:3")
@@ -112,3 +134,30 @@ func TestToJSON(t *testing.T) {
 
 	require.Contains(t, result, "test")
 }
+
+func TestGraphqlErrorsToDiags(t *testing.T) {
+	diags := graphqlErrorsToDiags([]graphqlError{
+		{Message: "Invalid name", Path: []string{"syntheticsCreatePrivateLocation", "0", "name"}, ErrorClass: "VALIDATION_ERROR"},
+		{Message: "Forbidden", Path: []string{"syntheticsCreatePrivateLocation"}},
+		{Message: "Something went wrong"},
+	})
+	require.Len(t, diags, 3)
+
+	require.Equal(t, diag.Error, diags[0].Severity)
+	require.Equal(t, "Invalid name", diags[0].Summary)
+	require.Equal(t, "path: syntheticsCreatePrivateLocation.0.name\nerrorClass: VALIDATION_ERROR", diags[0].Detail)
+
+	require.Equal(t, "Forbidden", diags[1].Summary)
+	require.Equal(t, "path: syntheticsCreatePrivateLocation", diags[1].Detail)
+
+	require.Equal(t, "Something went wrong", diags[2].Summary)
+	require.Empty(t, diags[2].Detail)
+}
+
+func TestDiagFromGraphQLErr_NotGraphQL(t *testing.T) {
+	require.Nil(t, diagFromGraphQLErr(nil))
+
+	diags := diagFromGraphQLErr(errors.New("connection refused"))
+	require.Len(t, diags, 1)
+	require.Equal(t, "connection refused", diags[0].Summary)
+}
@@ -1,15 +1,32 @@
 package newrelic
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// notificationsEntityInUseTimeout bounds how long deleting a notification channel or destination
+// waits for the resources referencing it to be released.
+var notificationsEntityInUseTimeout = 1 * time.Minute
+
+// notificationsEntityInUseDetail is attached to ENTITY_IN_USE errors to explain how to order deletions.
+const notificationsEntityInUseDetail = "The entity is still referenced by another resource. " +
+	"Reference it by attribute (e.g. `channel_id = newrelic_notification_channel.foo.id` in a workflow, " +
+	"`destination_id = newrelic_notification_destination.foo.id` in a channel) so Terraform destroys " +
+	"the workflow before its channels and the channels before their destination."
+
 func notificationsPropertySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -26,7 +43,7 @@ func notificationsPropertySchema() *schema.Resource {
 				Required:    true,
 				Description: "Notification property value.",
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
-					return oldValue == "terraform"
+					return oldValue == "terraform" || isRedactedNotificationPropertyValue(oldValue)
 				},
 			},
 			"label": {
@@ -46,6 +63,51 @@ func notificationsPropertySchema() *schema.Resource {
 	}
 }
 
+// Write-only property values, e.g. tokens, are returned masked by the API.
+func isRedactedNotificationPropertyValue(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
+
+// notificationsSecretPropertyKeys are the keys of the properties holding a secret.
+var notificationsSecretPropertyKeys = map[string]bool{
+	"apiKey":   true,
+	"password": true,
+	"secret":   true,
+	"token":    true,
+}
+
+// isSecretProperty reports whether a property read back from the API holds a secret, either because
+// its value is masked or because its key is the key of a secret.
+func isSecretProperty(property map[string]interface{}) bool {
+	key, _ := property["key"].(string)
+	value, _ := property["value"].(string)
+
+	return isRedactedNotificationPropertyValue(value) || notificationsSecretPropertyKeys[key]
+}
+
+// The API doesn't return the value of secret properties as it was configured. Keep the value from the
+// prior state for those properties, otherwise every plan would show a diff and re-send the masked value.
+func preserveNotificationSecretProperties(properties []map[string]interface{}, priorProperties []interface{}) []map[string]interface{} {
+	priorValues := map[string]string{}
+	for _, p := range priorProperties {
+		if prior, ok := p.(map[string]interface{}); ok {
+			priorValues[prior["key"].(string)] = prior["value"].(string)
+		}
+	}
+
+	for _, property := range properties {
+		if !isSecretProperty(property) {
+			continue
+		}
+
+		if priorValue, ok := priorValues[property["key"].(string)]; ok && priorValue != "" {
+			property["value"] = priorValue
+		}
+	}
+
+	return properties
+}
+
 // Builds an array of typed notifications error interface based on the GraphQL `response.errors` array.
 func buildAiNotificationsErrors(errors []ai.AiNotificationsError) diag.Diagnostics {
 	var diagErrors diag.Diagnostics
@@ -102,14 +164,58 @@ func buildAiNotificationsResponseError(err ai.AiNotificationsResponseError) diag
 func buildAiNotificationsResponseErrors(errors []notifications.AiNotificationsResponseError) diag.Diagnostics {
 	var diagErrors diag.Diagnostics
 	for _, err := range errors {
-		diagErrors = append(diagErrors, diag.Diagnostic{
+		diagnostic := diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  fmt.Sprintf("%s: %s", string(err.Type), err.Description),
-		})
+		}
+		if err.Type == notifications.AiNotificationsErrorTypeTypes.ENTITY_IN_USE {
+			diagnostic.Detail = notificationsEntityInUseDetail
+		}
+		diagErrors = append(diagErrors, diagnostic)
 	}
 	return diagErrors
 }
 
+// isNotificationsEntityInUse reports whether a delete was rejected because the entity is still referenced.
+func isNotificationsEntityInUse(errors []notifications.AiNotificationsResponseError) bool {
+	for _, err := range errors {
+		if err.Type == notifications.AiNotificationsErrorTypeTypes.ENTITY_IN_USE {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteNotificationsEntity runs deleteFunc and retries it while the entity is reported in use.
+// Workflows and channels referencing the entity are released asynchronously, so a delete issued
+// right after its dependents were destroyed in the same apply can be rejected for a short time.
+// The response errors of the last attempt are returned.
+func deleteNotificationsEntity(ctx context.Context, id string, deleteFunc func() (*notifications.AiNotificationsDeleteResponse, error)) ([]notifications.AiNotificationsResponseError, error) {
+	var responseErrors []notifications.AiNotificationsResponseError
+
+	err := resource.RetryContext(ctx, notificationsEntityInUseTimeout, func() *resource.RetryError {
+		response, err := deleteFunc()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		responseErrors = response.Errors
+		if isNotificationsEntityInUse(responseErrors) {
+			log.Printf("[WARN] Notification entity %s is still in use, retrying delete", id)
+			return resource.RetryableError(fmt.Errorf("notification entity %s is still in use", id))
+		}
+
+		return nil
+	})
+
+	// Running out of retries still reports the in-use errors of the last attempt.
+	if err != nil && !isNotificationsEntityInUse(responseErrors) {
+		return nil, err
+	}
+
+	return responseErrors, nil
+}
+
 func createMonitoringProperty() notifications.AiNotificationsPropertyInput {
 	return notifications.AiNotificationsPropertyInput{
 		Key:   "source",
@@ -117,3 +223,62 @@ func createMonitoringProperty() notifications.AiNotificationsPropertyInput {
 		Label: "terraform-source-internal",
 	}
 }
+
+// Appends the internal monitoring property unless it is already present, e.g. when
+// the properties were built from state that was read back from the API.
+func appendMonitoringProperty(properties []notifications.AiNotificationsPropertyInput) []notifications.AiNotificationsPropertyInput {
+	monitoringProperty := createMonitoringProperty()
+
+	for _, p := range properties {
+		if p.Key == monitoringProperty.Key {
+			return properties
+		}
+	}
+
+	return append(properties, monitoringProperty)
+}
+
+var (
+	awsAccountIDRegex = regexp.MustCompile(`^\d{12}$`)
+	awsRegionRegex    = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-\d+$`)
+)
+
+// Validates the properties New Relic needs to reach webhook and EventBridge destinations,
+// so that a malformed address fails at plan time rather than on apply.
+func validateNotificationDestinationProperties(destinationType string, properties []interface{}) error {
+	for _, p := range properties {
+		property, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := property["key"].(string)
+		value := property["value"].(string)
+
+		switch notifications.AiNotificationsDestinationType(destinationType) {
+		case notifications.AiNotificationsDestinationTypeTypes.WEBHOOK:
+			if key != "url" {
+				continue
+			}
+
+			u, err := url.Parse(value)
+			if err != nil {
+				return fmt.Errorf("property %q of a %s destination must be an absolute http(s) URL: %s", key, destinationType, err)
+			}
+
+			if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("property %q of a %s destination must be an absolute http(s) URL, got %q", key, destinationType, value)
+			}
+		case notifications.AiNotificationsDestinationTypeTypes.EVENT_BRIDGE:
+			if key == "AWSAccountId" && !awsAccountIDRegex.MatchString(value) {
+				return fmt.Errorf("property %q of a %s destination must be a 12 digit AWS account ID, got %q", key, destinationType, value)
+			}
+
+			if key == "AWSRegion" && !awsRegionRegex.MatchString(value) {
+				return fmt.Errorf("property %q of a %s destination must be an AWS region such as us-east-1, got %q", key, destinationType, value)
+			}
+		}
+	}
+
+	return nil
+}
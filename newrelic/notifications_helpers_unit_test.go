@@ -0,0 +1,204 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/stretchr/testify/require"
+)
+
+func testNotificationDestinationProperty(key string, value string) map[string]interface{} {
+	return map[string]interface{}{
+		"key":           key,
+		"value":         value,
+		"label":         "",
+		"display_value": "",
+	}
+}
+
+func TestValidateNotificationDestinationProperties(t *testing.T) {
+	cases := map[string]struct {
+		destinationType string
+		properties      []interface{}
+		expectedErr     string
+	}{
+		"webhook https url": {
+			destinationType: "WEBHOOK",
+			properties:      []interface{}{testNotificationDestinationProperty("url", "https://webhook.mywebhook.com/path?q=1")},
+		},
+		"webhook http url": {
+			destinationType: "WEBHOOK",
+			properties:      []interface{}{testNotificationDestinationProperty("url", "http://10.0.0.1:8080")},
+		},
+		"webhook relative url": {
+			destinationType: "WEBHOOK",
+			properties:      []interface{}{testNotificationDestinationProperty("url", "webhook.mywebhook.com")},
+			expectedErr:     `property "url" of a WEBHOOK destination must be an absolute http(s) URL, got "webhook.mywebhook.com"`,
+		},
+		"webhook non-http url": {
+			destinationType: "WEBHOOK",
+			properties:      []interface{}{testNotificationDestinationProperty("url", "ftp://webhook.mywebhook.com")},
+			expectedErr:     `property "url" of a WEBHOOK destination must be an absolute http(s) URL, got "ftp://webhook.mywebhook.com"`,
+		},
+		"webhook unparsable url": {
+			destinationType: "WEBHOOK",
+			properties:      []interface{}{testNotificationDestinationProperty("url", "https://webhook.com/%zz")},
+			expectedErr:     `property "url" of a WEBHOOK destination must be an absolute http(s) URL: parse "https://webhook.com/%zz": invalid URL escape "%zz"`,
+		},
+		"event bridge": {
+			destinationType: "EVENT_BRIDGE",
+			properties: []interface{}{
+				testNotificationDestinationProperty("AWSAccountId", "123456789012"),
+				testNotificationDestinationProperty("AWSRegion", "us-gov-west-1"),
+			},
+		},
+		"event bridge malformed account id": {
+			destinationType: "EVENT_BRIDGE",
+			properties: []interface{}{
+				testNotificationDestinationProperty("AWSAccountId", "1234-5678"),
+				testNotificationDestinationProperty("AWSRegion", "us-east-2"),
+			},
+			expectedErr: `property "AWSAccountId" of a EVENT_BRIDGE destination must be a 12 digit AWS account ID, got "1234-5678"`,
+		},
+		"event bridge malformed region": {
+			destinationType: "EVENT_BRIDGE",
+			properties: []interface{}{
+				testNotificationDestinationProperty("AWSAccountId", "123456789012"),
+				testNotificationDestinationProperty("AWSRegion", "US East (Ohio)"),
+			},
+			expectedErr: `property "AWSRegion" of a EVENT_BRIDGE destination must be an AWS region such as us-east-1, got "US East (Ohio)"`,
+		},
+		"other types are not validated": {
+			destinationType: "EMAIL",
+			properties:      []interface{}{testNotificationDestinationProperty("url", "not a url")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateNotificationDestinationProperties(tc.destinationType, tc.properties)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestIsSecretProperty(t *testing.T) {
+	cases := map[string]struct {
+		key    string
+		label  string
+		value  string
+		secret bool
+	}{
+		"masked value":         {key: "routingKey", value: "********", secret: true},
+		"api key":              {key: "apiKey", value: "abc123", secret: true},
+		"token":                {key: "token", secret: true},
+		"secret":               {key: "secret", value: "abc123", secret: true},
+		"password":             {key: "password", value: "abc123", secret: true},
+		"plain property":       {key: "teamName", label: "Team Name", value: "observability", secret: false},
+		"secret-looking label": {key: "routingKey", label: "Integration API Key", value: "abc123", secret: false},
+		"empty":                {key: "key", secret: false},
+	}
+
+	for name, tc := range cases {
+		property := map[string]interface{}{"key": tc.key, "value": tc.value, "label": tc.label}
+		require.Equal(t, tc.secret, isSecretProperty(property), name)
+	}
+}
+
+func TestDeleteNotificationsEntity(t *testing.T) {
+	defaultTimeout := notificationsEntityInUseTimeout
+	notificationsEntityInUseTimeout = 2 * time.Second
+	defer func() { notificationsEntityInUseTimeout = defaultTimeout }()
+
+	inUse := notifications.AiNotificationsResponseError{
+		Type:        notifications.AiNotificationsErrorTypeTypes.ENTITY_IN_USE,
+		Description: "entity is in use",
+	}
+	invalid := notifications.AiNotificationsResponseError{
+		Type:        notifications.AiNotificationsErrorTypeTypes.INVALID_PARAMETER,
+		Description: "does not correspond to any valid entity",
+	}
+
+	cases := map[string]struct {
+		responses      []*notifications.AiNotificationsDeleteResponse
+		err            error
+		expectedCalls  int
+		expectedErrors []notifications.AiNotificationsResponseError
+		expectedErr    string
+	}{
+		"deleted": {
+			responses:     []*notifications.AiNotificationsDeleteResponse{{IDs: []string{"abc"}}},
+			expectedCalls: 1,
+		},
+		"in use until dependents are released": {
+			responses: []*notifications.AiNotificationsDeleteResponse{
+				{Errors: []notifications.AiNotificationsResponseError{inUse}},
+				{IDs: []string{"abc"}},
+			},
+			expectedCalls: 2,
+		},
+		"other response errors are not retried": {
+			responses:      []*notifications.AiNotificationsDeleteResponse{{Errors: []notifications.AiNotificationsResponseError{invalid}}},
+			expectedCalls:  1,
+			expectedErrors: []notifications.AiNotificationsResponseError{invalid},
+		},
+		"request errors are not retried": {
+			err:           errors.New("request failed"),
+			expectedCalls: 1,
+			expectedErr:   "request failed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			responseErrors, err := deleteNotificationsEntity(context.Background(), "abc", func() (*notifications.AiNotificationsDeleteResponse, error) {
+				calls++
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return tc.responses[calls-1], nil
+			})
+
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedCalls, calls)
+			require.Equal(t, tc.expectedErrors, responseErrors)
+		})
+	}
+}
+
+func TestDeleteNotificationsEntity_StillInUse(t *testing.T) {
+	defaultTimeout := notificationsEntityInUseTimeout
+	notificationsEntityInUseTimeout = 100 * time.Millisecond
+	defer func() { notificationsEntityInUseTimeout = defaultTimeout }()
+
+	inUse := []notifications.AiNotificationsResponseError{{
+		Type:        notifications.AiNotificationsErrorTypeTypes.ENTITY_IN_USE,
+		Description: "entity is in use",
+	}}
+
+	responseErrors, err := deleteNotificationsEntity(context.Background(), "abc", func() (*notifications.AiNotificationsDeleteResponse, error) {
+		return &notifications.AiNotificationsDeleteResponse{Errors: inUse}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, inUse, responseErrors)
+
+	diags := buildAiNotificationsResponseErrors(responseErrors)
+	require.Len(t, diags, 1)
+	require.Equal(t, "ENTITY_IN_USE: entity is in use", diags[0].Summary)
+	require.Equal(t, notificationsEntityInUseDetail, diags[0].Detail)
+}
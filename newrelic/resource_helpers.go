@@ -105,6 +105,41 @@ func resourceImportStateWithMetadata(defaultIDCount int, attribute string) schem
 	}
 }
 
+// Handles importing of resources scoped to an account using an ID in the
+// form of `<account_id>:<id>`. The account ID is set into the `account_id`
+// attribute and the resource ID is set to the remaining `<id>`, so that the
+// resource is read from the given account instead of the provider's default.
+// An ID without the account ID prefix is imported as is, into the provider's
+// default account.
+func resourceImportStateWithAccountID() schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		var accountID int
+		if providerConfig, ok := meta.(*ProviderConfig); ok {
+			accountID = providerConfig.AccountID
+		}
+
+		if strings.Contains(d.Id(), ":") {
+			rawAccountID, id, err := parseCompositeID(d.Id())
+			if err == nil {
+				accountID, err = strconv.Atoi(rawAccountID)
+			}
+			if err != nil {
+				return []*schema.ResourceData{}, fmt.Errorf("import ID must be in the form of <account_id>:<id>, got: %s", d.Id())
+			}
+
+			d.SetId(id)
+		}
+
+		if accountID != 0 {
+			if err := d.Set("account_id", accountID); err != nil {
+				return []*schema.ResourceData{}, err
+			}
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 // Selects the proper accountID for usage within a resource. An account ID provided
 // within a `resource` block will override a `provider` block account ID. This ensures
 // resources can be scoped to specific accounts. Bear in mind those accounts must be
@@ -1,14 +1,20 @@
 package newrelic
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/synthetics"
 )
 
@@ -200,6 +206,30 @@ func expandSyntheticsTagValues(v []interface{}) []string {
 	return values
 }
 
+// expandSyntheticsMonitorEntityTags expands the `tag` blocks of a monitor for the tagging API.
+func expandSyntheticsMonitorEntityTags(tags []interface{}) []entities.TaggingTagInput {
+	out := make([]entities.TaggingTagInput, len(tags))
+	for i, t := range expandSyntheticsTags(tags) {
+		out[i] = entities.TaggingTagInput{Key: t.Key, Values: t.Values}
+	}
+	return out
+}
+
+// updateSyntheticsMonitorTags applies changes to the `tag` attribute through the tagging API
+// rather than resending every tag with the monitor update.
+func updateSyntheticsMonitorTags(ctx context.Context, client entityTagger, d *schema.ResourceData) error {
+	if !d.HasChange("tag") {
+		return nil
+	}
+
+	o, n := d.GetChange("tag")
+
+	return updateEntityTags(ctx, client, "synthetics monitor", common.EntityGUID(d.Id()),
+		expandSyntheticsMonitorEntityTags(o.(*schema.Set).List()),
+		expandSyntheticsMonitorEntityTags(n.(*schema.Set).List()),
+	)
+}
+
 func expandStringSlice(strings []interface{}) []string {
 	out := make([]string, len(strings))
 
@@ -389,6 +419,59 @@ func getPublicLocationsFromEntityTags(tags []entities.EntityTag) []string {
 	return out
 }
 
+// Returns the domain ID encoded in a private location entity GUID (`<accountID>|SYNTH|PRIVATE_LOCATION|<domainID>`).
+func parseSyntheticsPrivateLocationGUID(guid string) (string, bool) {
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return "", false
+	}
+
+	parts := strings.Split(string(decoded), "|")
+	if len(parts) != 4 || parts[1] != "SYNTH" || parts[2] != "PRIVATE_LOCATION" || parts[3] == "" {
+		return "", false
+	}
+
+	return parts[3], true
+}
+
+// Returns the entity GUID of the private location with the given domain ID.
+func syntheticsPrivateLocationGUID(accountID int, domainID string) string {
+	return base64.RawStdEncoding.EncodeToString([]byte(fmt.Sprintf("%d|SYNTH|PRIVATE_LOCATION|%s", accountID, domainID)))
+}
+
+// Returns the domain ID of a private location, whether it is given as an entity GUID or already as the domain ID.
+func syntheticsPrivateLocationDomainID(location string) string {
+	if domainID, ok := parseSyntheticsPrivateLocationGUID(location); ok {
+		return domainID
+	}
+
+	return location
+}
+
+// Hashes private locations by domain ID so a GUID and its domain ID are the same set element.
+func hashSyntheticsPrivateLocation(v interface{}) int {
+	return schema.HashString(syntheticsPrivateLocationDomainID(v.(string)))
+}
+
+// Converts private location domain IDs to the entity GUIDs expected by the API. GUIDs are passed through as-is.
+func expandSyntheticsPrivateLocationGUIDs(locations []string, accountID int) []string {
+	if locations == nil {
+		return nil
+	}
+
+	out := make([]string, len(locations))
+	for i, location := range locations {
+		if _, ok := parseSyntheticsPrivateLocationGUID(location); ok {
+			out[i] = location
+			continue
+		}
+
+		out[i] = syntheticsPrivateLocationGUID(accountID, location)
+	}
+
+	return out
+}
+
 func getMonitorID(monitorGUID string) string {
 	decodedGUID, _ := base64.RawStdEncoding.DecodeString(monitorGUID)
 	splitGUID := strings.Split(string(decodedGUID), "|")
@@ -406,3 +489,125 @@ var syntheticsMonitorTagKeyToSchemaAttrMap = map[string]string{
 	"runtimeTypeVersion": "runtime_type_version",
 	"scriptLanguage":     "script_language",
 }
+
+// syntheticsMonitorDeleter is the subset of the synthetics client used to delete monitors.
+type syntheticsMonitorDeleter interface {
+	SyntheticsDeleteMonitorWithContext(context.Context, synthetics.EntityGUID) (*synthetics.SyntheticsMonitorDeleteMutationResult, error)
+}
+
+type syntheticsMonitorDeleteErrorClass string
+
+const (
+	syntheticsMonitorDeleteErrorNone      syntheticsMonitorDeleteErrorClass = "none"
+	syntheticsMonitorDeleteErrorNotFound  syntheticsMonitorDeleteErrorClass = "not_found"
+	syntheticsMonitorDeleteErrorTransient syntheticsMonitorDeleteErrorClass = "transient"
+	syntheticsMonitorDeleteErrorFailure   syntheticsMonitorDeleteErrorClass = "failure"
+)
+
+// The timeout used when retrying the deletion of a Synthetics monitor after a transient error.
+var syntheticsMonitorDeleteTimeout = 1 * time.Minute
+
+// classifySyntheticsMonitorDeleteError sorts the errors returned by the syntheticsDeleteMonitor mutation.
+// A monitor that can not be found has already been deleted, server errors and timeouts are transient
+// and all other errors are genuine failures.
+func classifySyntheticsMonitorDeleteError(err error) syntheticsMonitorDeleteErrorClass {
+	if err == nil {
+		return syntheticsMonitorDeleteErrorNone
+	}
+
+	switch e := err.(type) {
+	case *errors.NotFound:
+		return syntheticsMonitorDeleteErrorNotFound
+	case *errors.MaxRetriesReached:
+		return syntheticsMonitorDeleteErrorTransient
+	case interface{ IsRetryableError() bool }:
+		if e.IsRetryableError() {
+			return syntheticsMonitorDeleteErrorTransient
+		}
+	}
+
+	message := strings.ToLower(err.Error())
+	if strings.Contains(message, "not found") || strings.Contains(message, "does not exist") {
+		return syntheticsMonitorDeleteErrorNotFound
+	}
+
+	return syntheticsMonitorDeleteErrorFailure
+}
+
+// deleteSyntheticsMonitor deletes the Synthetics monitor with the given GUID, retrying transient errors.
+// A monitor that has already been deleted is not reported as an error.
+func deleteSyntheticsMonitor(ctx context.Context, client syntheticsMonitorDeleter, guid synthetics.EntityGUID) error {
+	return resource.RetryContext(ctx, syntheticsMonitorDeleteTimeout, func() *resource.RetryError {
+		_, err := client.SyntheticsDeleteMonitorWithContext(ctx, guid)
+
+		switch classifySyntheticsMonitorDeleteError(err) {
+		case syntheticsMonitorDeleteErrorNone:
+			return nil
+		case syntheticsMonitorDeleteErrorNotFound:
+			log.Printf("[INFO] Synthetics monitor %s has already been deleted", guid)
+			return nil
+		case syntheticsMonitorDeleteErrorTransient:
+			log.Printf("[WARN] Transient error deleting Synthetics monitor %s, retrying: %s", guid, err)
+			return resource.RetryableError(err)
+		default:
+			return resource.NonRetryableError(err)
+		}
+	})
+}
+
+// isSyntheticsMonitorEntityDeleted reports whether the entity returned for a Synthetics monitor
+// no longer exists. A deleted monitor can still be returned with the DELETED status while the
+// entity index catches up with the deletion.
+func isSyntheticsMonitorEntityDeleted(resp *entities.EntityInterface) bool {
+	if resp == nil || *resp == nil {
+		return true
+	}
+
+	if e, ok := (*resp).(*entities.SyntheticMonitorEntity); ok {
+		return e.MonitorSummary.Status == entities.SyntheticMonitorStatusTypes.DELETED
+	}
+
+	return false
+}
+
+// The runtimes monitors should be migrated to, by monitor type.
+var syntheticsMonitorRuntimeMigrations = map[string]struct {
+	runtimeType        string
+	runtimeTypeVersion string
+}{
+	string(SyntheticsMonitorTypes.BROWSER):        {"CHROME_BROWSER", "100"},
+	string(SyntheticsMonitorTypes.SCRIPT_BROWSER): {"CHROME_BROWSER", "100"},
+	string(SyntheticsMonitorTypes.SCRIPT_API):     {"NODE_API", "16.10"},
+}
+
+// syntheticsMonitorLegacyRuntimeDiagnostics returns a warning with the migration path for monitors
+// that run on the deprecated legacy runtime, i.e. monitors of a runtime based type without a runtime type.
+func syntheticsMonitorLegacyRuntimeDiagnostics(monitorType string, runtimeType string) diag.Diagnostics {
+	migration, ok := syntheticsMonitorRuntimeMigrations[monitorType]
+	if !ok || runtimeType != "" {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s monitor uses the deprecated legacy runtime", monitorType),
+			Detail: fmt.Sprintf("The legacy Synthetics runtime is deprecated. Migrate the monitor to the new runtime by setting "+
+				"runtime_type = %q and runtime_type_version = %q.", migration.runtimeType, migration.runtimeTypeVersion),
+		},
+	}
+}
+
+// resourceNewRelicSyntheticsMonitorRuntimeCustomizeDiff reports monitors planned on the legacy runtime.
+// CustomizeDiff can not return warnings, so the warning is logged.
+func resourceNewRelicSyntheticsMonitorRuntimeCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("runtime_type") {
+		return nil
+	}
+
+	for _, w := range syntheticsMonitorLegacyRuntimeDiagnostics(diff.Get("type").(string), diff.Get("runtime_type").(string)) {
+		log.Printf("[WARN] %s: %s", w.Summary, w.Detail)
+	}
+
+	return nil
+}
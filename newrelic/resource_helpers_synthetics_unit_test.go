@@ -0,0 +1,385 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/synthetics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsPrivateLocationGUIDAndDomainIDEquivalence(t *testing.T) {
+	domainID := "9bd8c7f4-2c5e-4a57-9d6f-3a2b1c0d9e8f"
+	guid := base64.RawStdEncoding.EncodeToString([]byte("123456|SYNTH|PRIVATE_LOCATION|" + domainID))
+
+	require.Equal(t, guid, syntheticsPrivateLocationGUID(123456, domainID))
+
+	// Both forms resolve to the same domain ID
+	parsed, ok := parseSyntheticsPrivateLocationGUID(guid)
+	require.True(t, ok)
+	require.Equal(t, domainID, parsed)
+	require.Equal(t, domainID, syntheticsPrivateLocationDomainID(guid))
+	require.Equal(t, domainID, syntheticsPrivateLocationDomainID(domainID))
+	require.Equal(t, hashSyntheticsPrivateLocation(guid), hashSyntheticsPrivateLocation(domainID))
+
+	// Both forms are sent to the API as the same GUID
+	require.Equal(t, []string{guid, guid}, expandSyntheticsPrivateLocationGUIDs([]string{domainID, guid}, 123456))
+
+	// Other entity GUIDs are not mistaken for private locations
+	monitorGUID := base64.RawStdEncoding.EncodeToString([]byte("123456|SYNTH|MONITOR|abc"))
+	_, ok = parseSyntheticsPrivateLocationGUID(monitorGUID)
+	require.False(t, ok)
+	require.Equal(t, monitorGUID, syntheticsPrivateLocationDomainID(monitorGUID))
+}
+
+func TestSyntheticsMonitorPeriodValidation(t *testing.T) {
+	validPeriods := []string{
+		"EVERY_MINUTE",
+		"EVERY_5_MINUTES",
+		"EVERY_10_MINUTES",
+		"EVERY_15_MINUTES",
+		"EVERY_30_MINUTES",
+		"EVERY_HOUR",
+		"EVERY_6_HOURS",
+		"EVERY_12_HOURS",
+		"EVERY_DAY",
+	}
+	invalidPeriods := []string{
+		"",
+		"every_minute",
+		"EVERY_2_MINUTES",
+		"EVERY_2_HOURS",
+		"EVERY_WEEK",
+		"60",
+	}
+
+	// Every period must also map to minutes, which backs `period_in_minutes`
+	require.ElementsMatch(t, validPeriods, listValidSyntheticsMonitorPeriods())
+	for _, period := range validPeriods {
+		require.Contains(t, syntheticsMonitorPeriodInMinutesValueMap, synthetics.SyntheticsMonitorPeriod(period))
+	}
+
+	resources := map[string]*schema.Resource{
+		"newrelic_synthetics_monitor":              resourceNewRelicSyntheticsMonitor(),
+		"newrelic_synthetics_script_monitor":       resourceNewRelicSyntheticsScriptMonitor(),
+		"newrelic_synthetics_step_monitor":         resourceNewRelicSyntheticsStepMonitor(),
+		"newrelic_synthetics_broken_links_monitor": resourceNewRelicSyntheticsBrokenLinksMonitor(),
+		"newrelic_synthetics_cert_check_monitor":   resourceNewRelicSyntheticsCertCheckMonitor(),
+	}
+
+	for name, r := range resources {
+		validate := r.Schema["period"].ValidateFunc
+		require.NotNil(t, validate, name)
+
+		for _, period := range validPeriods {
+			_, errs := validate(period, "period")
+			require.Empty(t, errs, "%s: %s", name, period)
+		}
+
+		for _, period := range invalidPeriods {
+			_, errs := validate(period, "period")
+			require.NotEmpty(t, errs, "%s: %s", name, period)
+		}
+	}
+}
+
+// Mock of a NerdGraph error response, which reports whether the error can be retried.
+type testSyntheticsGraphQLError struct {
+	message   string
+	retryable bool
+}
+
+func (e *testSyntheticsGraphQLError) Error() string          { return e.message }
+func (e *testSyntheticsGraphQLError) IsRetryableError() bool { return e.retryable }
+
+// Mock of the synthetics client returning the given errors on consecutive delete calls.
+type testSyntheticsMonitorDeleter struct {
+	errs  []error
+	calls int
+}
+
+func (m *testSyntheticsMonitorDeleter) SyntheticsDeleteMonitorWithContext(ctx context.Context, guid synthetics.EntityGUID) (*synthetics.SyntheticsMonitorDeleteMutationResult, error) {
+	m.calls++
+	if m.calls <= len(m.errs) && m.errs[m.calls-1] != nil {
+		return nil, m.errs[m.calls-1]
+	}
+
+	return &synthetics.SyntheticsMonitorDeleteMutationResult{DeletedGUID: guid}, nil
+}
+
+func TestClassifySyntheticsMonitorDeleteError(t *testing.T) {
+	cases := map[string]struct {
+		err      error
+		expected syntheticsMonitorDeleteErrorClass
+	}{
+		"no error":          {nil, syntheticsMonitorDeleteErrorNone},
+		"not found":         {errors.NewNotFound("resource not found"), syntheticsMonitorDeleteErrorNotFound},
+		"not found message": {&testSyntheticsGraphQLError{message: "Monitor not found"}, syntheticsMonitorDeleteErrorNotFound},
+		"timeout":           {&testSyntheticsGraphQLError{message: "Timeout", retryable: true}, syntheticsMonitorDeleteErrorTransient},
+		"max retries":       {errors.NewMaxRetriesReached("server error"), syntheticsMonitorDeleteErrorTransient},
+		"unauthorized":      {&testSyntheticsGraphQLError{message: "Access denied"}, syntheticsMonitorDeleteErrorFailure},
+		"other":             {fmt.Errorf("unexpected"), syntheticsMonitorDeleteErrorFailure},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, classifySyntheticsMonitorDeleteError(tc.err))
+		})
+	}
+}
+
+func TestDeleteSyntheticsMonitor_RetriesTransientError(t *testing.T) {
+	client := &testSyntheticsMonitorDeleter{
+		errs: []error{&testSyntheticsGraphQLError{message: "Timeout", retryable: true}},
+	}
+
+	err := deleteSyntheticsMonitor(context.Background(), client, "MXxTWU5USHxNT05JVE9SfDE")
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls)
+}
+
+func TestDeleteSyntheticsMonitor_ReportsFailure(t *testing.T) {
+	client := &testSyntheticsMonitorDeleter{
+		errs: []error{&testSyntheticsGraphQLError{message: "Access denied"}},
+	}
+
+	err := deleteSyntheticsMonitor(context.Background(), client, "MXxTWU5USHxNT05JVE9SfDE")
+	require.EqualError(t, err, "Access denied")
+	require.Equal(t, 1, client.calls)
+}
+
+func TestDeleteSyntheticsMonitor_AlreadyDeleted(t *testing.T) {
+	client := &testSyntheticsMonitorDeleter{
+		errs: []error{errors.NewNotFound("resource not found")},
+	}
+
+	err := deleteSyntheticsMonitor(context.Background(), client, "MXxTWU5USHxNT05JVE9SfDE")
+	require.NoError(t, err)
+	require.Equal(t, 1, client.calls)
+}
+
+func TestIsSyntheticsMonitorEntityDeleted(t *testing.T) {
+	var missing entities.EntityInterface
+	require.True(t, isSyntheticsMonitorEntityDeleted(&missing))
+
+	var deleted entities.EntityInterface = &entities.SyntheticMonitorEntity{
+		MonitorSummary: entities.SyntheticMonitorSummaryData{Status: entities.SyntheticMonitorStatusTypes.DELETED},
+	}
+	require.True(t, isSyntheticsMonitorEntityDeleted(&deleted))
+
+	var enabled entities.EntityInterface = &entities.SyntheticMonitorEntity{
+		MonitorSummary: entities.SyntheticMonitorSummaryData{Status: entities.SyntheticMonitorStatusTypes.ENABLED},
+	}
+	require.False(t, isSyntheticsMonitorEntityDeleted(&enabled))
+}
+
+func TestSyntheticsMonitorLegacyRuntimeDiagnostics(t *testing.T) {
+	cases := map[string]struct {
+		monitorType string
+		runtimeType string
+		expectWarn  bool
+	}{
+		"legacy browser":        {"BROWSER", "", true},
+		"new browser":           {"BROWSER", "CHROME_BROWSER", false},
+		"legacy script api":     {"SCRIPT_API", "", true},
+		"new script api":        {"SCRIPT_API", "NODE_API", false},
+		"legacy script browser": {"SCRIPT_BROWSER", "", true},
+		"simple":                {"SIMPLE", "", false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := syntheticsMonitorLegacyRuntimeDiagnostics(tc.monitorType, tc.runtimeType)
+			if !tc.expectWarn {
+				require.Empty(t, diags)
+				return
+			}
+
+			require.Len(t, diags, 1)
+			require.Equal(t, diag.Warning, diags[0].Severity)
+			require.Contains(t, diags[0].Detail, "runtime_type = ")
+		})
+	}
+}
+
+func TestSyntheticsMonitorRuntimeCustomizeDiff(t *testing.T) {
+	r := resourceNewRelicSyntheticsScriptMonitor()
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	cases := map[string]struct {
+		runtimeType string
+		expectWarn  bool
+	}{
+		"legacy runtime": {"", true},
+		"new runtime":    {"NODE_API", false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			logs.Reset()
+
+			config := map[string]interface{}{
+				"name":             "script",
+				"type":             "SCRIPT_API",
+				"period":           "EVERY_HOUR",
+				"status":           "ENABLED",
+				"locations_public": []interface{}{"AP_SOUTH_1"},
+			}
+			if tc.runtimeType != "" {
+				config["runtime_type"] = tc.runtimeType
+				config["runtime_type_version"] = "16.10"
+			}
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectWarn, strings.Contains(logs.String(), "deprecated legacy runtime"))
+		})
+	}
+}
+
+func TestSyntheticsMonitorURIValidation(t *testing.T) {
+	validateFunc := resourceNewRelicSyntheticsMonitor().Schema["uri"].ValidateFunc
+
+	for _, uri := range []string{"https://www.one.newrelic.com", "http://example.com:8080/health?full=true"} {
+		_, errs := validateFunc(uri, "uri")
+		require.Empty(t, errs, uri)
+	}
+
+	for _, uri := range []string{"www.one.newrelic.com", "https://", "ftp://example.com", "https//example.com", ""} {
+		_, errs := validateFunc(uri, "uri")
+		require.NotEmpty(t, errs, uri)
+	}
+}
+
+func testSyntheticsMonitorTags(tags map[string][]string) []interface{} {
+	var out []interface{}
+	for k, v := range tags {
+		values := make([]interface{}, len(v))
+		for i, value := range v {
+			values[i] = value
+		}
+		out = append(out, map[string]interface{}{"key": k, "values": values})
+	}
+	return out
+}
+
+// testSyntheticsMonitorTagUpdateData returns resource data planned to move a monitor from the
+// old tags to the new ones.
+func testSyntheticsMonitorTagUpdateData(t *testing.T, oldTags map[string][]string, newTags map[string][]string) *schema.ResourceData {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	config := map[string]interface{}{
+		"name":             "monitor",
+		"type":             "SIMPLE",
+		"period":           "EVERY_MINUTE",
+		"status":           "ENABLED",
+		"uri":              "https://www.one.newrelic.com",
+		"locations_public": []interface{}{"AP_SOUTH_1"},
+		"tag":              testSyntheticsMonitorTags(oldTags),
+	}
+
+	prior, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	state, err := schema.InternalMap(r.Schema).Data(nil, prior)
+	require.NoError(t, err)
+	state.SetId("MXxTWU5USHxNT05JVE9SfDE")
+
+	config["tag"] = testSyntheticsMonitorTags(newTags)
+	diff, err := r.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+
+	d, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+	require.NoError(t, err)
+
+	return d
+}
+
+func TestUpdateSyntheticsMonitorTags_SingleValueChanged(t *testing.T) {
+	d := testSyntheticsMonitorTagUpdateData(t,
+		map[string][]string{"team": {"observability"}, "environment": {"production"}, "owner": {"jdoe"}},
+		map[string][]string{"team": {"observability"}, "environment": {"staging"}, "owner": {"jdoe"}},
+	)
+
+	client := &testEntityTagger{}
+	require.NoError(t, updateSyntheticsMonitorTags(context.Background(), client, d))
+
+	require.Equal(t, 2, client.calls)
+	require.Empty(t, client.deletedKeys)
+	require.Equal(t, []entities.TaggingTagValueInput{{Key: "environment", Value: "production"}}, client.deletedValues)
+	require.Equal(t, []entities.TaggingTagInput{{Key: "environment", Values: []string{"staging"}}}, client.added)
+}
+
+func TestUpdateSyntheticsMonitorTags_Unchanged(t *testing.T) {
+	tags := map[string][]string{"team": {"observability"}}
+	d := testSyntheticsMonitorTagUpdateData(t, tags, tags)
+
+	client := &testEntityTagger{}
+	require.NoError(t, updateSyntheticsMonitorTags(context.Background(), client, d))
+	require.Zero(t, client.calls)
+}
+
+func TestSyntheticsMonitorLocationsPublicReorderedHasNoDiff(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	var entity entities.EntityInterface = &entities.SyntheticMonitorEntity{
+		Name:           "simple",
+		MonitorType:    entities.SyntheticMonitorTypeTypes.SIMPLE,
+		MonitoredURL:   "https://www.example.com",
+		MonitorSummary: entities.SyntheticMonitorSummaryData{Status: entities.SyntheticMonitorStatusTypes.ENABLED},
+		Tags: []entities.EntityTag{
+			{Key: "publicLocation", Values: []string{"Tokyo, JP", "Washington, DC, USA", "Dublin, IE"}},
+		},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("MXxTWU5USHxNT05JVE9SfDE")
+	setCommonSyntheticsMonitorAttributes(&entity, d)
+	require.ElementsMatch(t, []interface{}{"AP_NORTHEAST_1", "US_EAST_1", "EU_WEST_1"}, d.Get("locations_public").(*schema.Set).List())
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "simple",
+		"type":             "SIMPLE",
+		"uri":              "https://www.example.com",
+		"period":           "EVERY_MINUTE",
+		"status":           "ENABLED",
+		"locations_public": []interface{}{"EU_WEST_1", "US_EAST_1", "AP_NORTHEAST_1"},
+	})
+
+	diff, err := r.Diff(context.Background(), d.State(), config, nil)
+	require.NoError(t, err)
+
+	if diff != nil {
+		for k := range diff.Attributes {
+			require.False(t, strings.HasPrefix(k, "locations_public"), "unexpected diff on %s", k)
+		}
+	}
+
+	config.Config["locations_public"] = []interface{}{"EU_WEST_1", "US_EAST_1", "AP_SOUTH_1"}
+	config = terraform.NewResourceConfigRaw(config.Config)
+
+	diff, err = r.Diff(context.Background(), d.State(), config, nil)
+	require.NoError(t, err)
+
+	changed := false
+	for k := range diff.Attributes {
+		changed = changed || strings.HasPrefix(k, "locations_public")
+	}
+	require.True(t, changed, "expected a diff on locations_public")
+}
@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceImportStateWithAccountID(t *testing.T) {
+	cases := map[string]struct {
+		importID          string
+		expectedID        string
+		expectedAccountID int
+		expectedErr       string
+	}{
+		"id only": {
+			importID:   "f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01",
+			expectedID: "f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01",
+		},
+		"account id and id": {
+			importID:          "12345:f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01",
+			expectedID:        "f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01",
+			expectedAccountID: 12345,
+		},
+		"invalid account id": {
+			importID:    "account:f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01",
+			expectedErr: "import ID must be in the form of <account_id>:<id>, got: account:f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01",
+		},
+		"missing id": {
+			importID:    "12345:",
+			expectedErr: "import ID must be in the form of <account_id>:<id>, got: 12345:",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := resourceNewRelicNotificationDestination().TestResourceData()
+			d.SetId(tc.importID)
+
+			result, err := resourceImportStateWithAccountID()(context.Background(), d, nil)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			require.Equal(t, tc.expectedID, result[0].Id())
+			require.Equal(t, tc.expectedAccountID, result[0].Get("account_id"))
+		})
+	}
+}
+
+func TestResourceImportStateWithAccountID_DefaultAccount(t *testing.T) {
+	meta := &ProviderConfig{AccountID: 1}
+
+	d := resourceNewRelicNotificationDestination().TestResourceData()
+	d.SetId("f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01")
+
+	result, err := resourceImportStateWithAccountID()(context.Background(), d, meta)
+	require.NoError(t, err)
+	require.Equal(t, "f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01", result[0].Id())
+	require.Equal(t, 1, result[0].Get("account_id"))
+
+	// An account ID prefix takes precedence over the default account
+	d.SetId("12345:f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01")
+
+	result, err = resourceImportStateWithAccountID()(context.Background(), d, meta)
+	require.NoError(t, err)
+	require.Equal(t, "f8fdd8f6-0a1a-4a7d-9e2b-7d7a2c5b9e01", result[0].Id())
+	require.Equal(t, 12345, result[0].Get("account_id"))
+}
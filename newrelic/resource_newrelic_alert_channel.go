@@ -6,13 +6,19 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
 )
 
+// The timeout used when retrying to read an alert channel after a transient error.
+var alertChannelReadTimeout = 1 * time.Minute
+
 var alertChannelTypes = map[string][]string{
 	"email": {
 		"include_json_attachment",
@@ -306,17 +312,65 @@ func resourceNewRelicAlertChannelRead(ctx context.Context, d *schema.ResourceDat
 	accountID := selectAccountID(providerConfig, d)
 	updatedContext := updateContextWithAccountID(ctx, accountID)
 
-	channel, err := client.Alerts.GetChannelWithContext(updatedContext, int(id))
+	channel, err := getAlertChannel(updatedContext, &client.Alerts, int(id))
 	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if channel == nil {
+		log.Printf("[WARN] New Relic alert channel %v not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(flattenAlertChannel(channel, d))
+}
+
+// alertChannelGetter is the subset of the alerts client used to read alert channels.
+type alertChannelGetter interface {
+	GetChannelWithContext(context.Context, int) (*alerts.Channel, error)
+}
+
+// getAlertChannel reads the alert channel with the given ID. A nil channel without an error
+// means the channel has been deleted. Transient errors are retried and never reported as a
+// deleted channel, so an outage of the API does not remove the channel from state.
+func getAlertChannel(ctx context.Context, client alertChannelGetter, id int) (*alerts.Channel, error) {
+	var channel *alerts.Channel
+
+	err := resource.RetryContext(ctx, alertChannelReadTimeout, func() *resource.RetryError {
+		var err error
+		channel, err = client.GetChannelWithContext(ctx, id)
+		if err == nil {
+			return nil
+		}
+
 		if _, ok := err.(*errors.NotFound); ok {
-			d.SetId("")
+			channel = nil
 			return nil
 		}
 
-		return diag.FromErr(err)
+		if isTransientAlertChannelError(err) {
+			log.Printf("[WARN] Transient error reading New Relic alert channel %v, retrying: %s", id, err)
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	return channel, err
+}
+
+// isTransientAlertChannelError reports whether the error of a REST API call is a server error
+// that may succeed when retried.
+func isTransientAlertChannelError(err error) bool {
+	switch err.(type) {
+	case *errors.MaxRetriesReached:
+		return true
+	case *errors.UnexpectedStatusCode:
+		return strings.HasPrefix(err.Error(), "5")
 	}
 
-	return diag.FromErr(flattenAlertChannel(channel, d))
+	return false
 }
 
 func resourceNewRelicAlertChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,89 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock of the alerts client returning the given errors on consecutive reads before returning the channel.
+type testAlertChannelGetter struct {
+	errs    []error
+	channel *alerts.Channel
+	calls   int
+}
+
+func (m *testAlertChannelGetter) GetChannelWithContext(ctx context.Context, id int) (*alerts.Channel, error) {
+	m.calls++
+	if m.calls <= len(m.errs) {
+		return nil, m.errs[m.calls-1]
+	}
+
+	return m.channel, nil
+}
+
+func TestGetAlertChannel_Found(t *testing.T) {
+	client := &testAlertChannelGetter{channel: &alerts.Channel{ID: 123, Name: "foo"}}
+
+	channel, err := getAlertChannel(context.Background(), client, 123)
+	require.NoError(t, err)
+	require.Equal(t, 123, channel.ID)
+	require.Equal(t, 1, client.calls)
+}
+
+func TestGetAlertChannel_Missing(t *testing.T) {
+	client := &testAlertChannelGetter{
+		errs: []error{errors.NewNotFoundf("no channel found for id %d", 123)},
+	}
+
+	channel, err := getAlertChannel(context.Background(), client, 123)
+	require.NoError(t, err)
+	require.Nil(t, channel)
+	require.Equal(t, 1, client.calls)
+}
+
+func TestGetAlertChannel_TransientServerError(t *testing.T) {
+	client := &testAlertChannelGetter{
+		errs:    []error{errors.NewUnexpectedStatusCode(http.StatusInternalServerError, "internal server error")},
+		channel: &alerts.Channel{ID: 123, Name: "foo"},
+	}
+
+	channel, err := getAlertChannel(context.Background(), client, 123)
+	require.NoError(t, err)
+	require.Equal(t, 123, channel.ID)
+	require.Equal(t, 2, client.calls)
+}
+
+func TestGetAlertChannel_PersistentServerError(t *testing.T) {
+	timeout := alertChannelReadTimeout
+	alertChannelReadTimeout = 1 * time.Second
+	defer func() { alertChannelReadTimeout = timeout }()
+
+	serverError := errors.NewUnexpectedStatusCode(http.StatusInternalServerError, "internal server error")
+	client := &testAlertChannelGetter{
+		errs: []error{serverError, serverError, serverError, serverError, serverError, serverError},
+	}
+
+	channel, err := getAlertChannel(context.Background(), client, 123)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "500 response returned")
+	require.Nil(t, channel)
+}
+
+func TestGetAlertChannel_ClientError(t *testing.T) {
+	client := &testAlertChannelGetter{
+		errs: []error{errors.NewUnauthorizedError()},
+	}
+
+	_, err := getAlertChannel(context.Background(), client, 123)
+	require.Error(t, err)
+	require.Equal(t, 1, client.calls)
+}
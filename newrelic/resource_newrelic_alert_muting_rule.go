@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -142,6 +143,9 @@ func resourceNewRelicAlertMutingRule() *schema.Resource {
 										Required:     true,
 										Description:  "The operator used to compare the attribute's value with the supplied value(s).",
 										ValidateFunc: validation.StringInSlice([]string{"ANY", "CONTAINS", "ENDS_WITH", "EQUALS", "IN", "IS_BLANK", "IS_NOT_BLANK", "NOT_CONTAINS", "NOT_ENDS_WITH", "NOT_EQUALS", "NOT_IN", "NOT_STARTS_WITH", "STARTS_WITH"}, true),
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											return strings.EqualFold(old, new) // Case fold this attribute when diffing
+										},
 									},
 									"values": {
 										Type:        schema.TypeList,
@@ -158,6 +162,9 @@ func resourceNewRelicAlertMutingRule() *schema.Resource {
 							Required:     true,
 							Description:  "The operator used to combine all the MutingRuleConditions within the group.",
 							ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, true),
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new) // Case fold this attribute when diffing
+							},
 						},
 					},
 				},
@@ -259,6 +266,9 @@ func resourceNewRelicAlertMutingRuleRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	// The account ID is part of the resource ID, which restores it on import.
+	_ = d.Set("account_id", accountID)
+
 	return diag.FromErr(flattenMutingRule(mutingRule, d))
 }
 
@@ -99,6 +99,47 @@ func TestAccNewRelicAlertMutingRule_Basic(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicAlertMutingRule_ToggleEnabled(t *testing.T) {
+	resourceName := "newrelic_alert_muting_rule.foo"
+	rName := acctest.RandString(5)
+	var mutingRuleID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicAlertMutingRuleDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicAlertMutingRuleEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertMutingRuleExists(resourceName),
+					testAccCheckNewRelicAlertMutingRuleSameID(resourceName, &mutingRuleID),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// Test: Disable in-place
+			{
+				Config: testAccNewRelicAlertMutingRuleEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertMutingRuleExists(resourceName),
+					testAccCheckNewRelicAlertMutingRuleSameID(resourceName, &mutingRuleID),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			// Test: Enable in-place
+			{
+				Config: testAccNewRelicAlertMutingRuleEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertMutingRuleExists(resourceName),
+					testAccCheckNewRelicAlertMutingRuleSameID(resourceName, &mutingRuleID),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNewRelicAlertMutingRule_BadInput(t *testing.T) {
 	rName := acctest.RandString(5)
 
@@ -209,6 +250,52 @@ func TestAccNewRelicAlertMutingRule_WithSchedule(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicAlertMutingRule_ImportWeeklySchedule(t *testing.T) {
+	resourceName := "newrelic_alert_muting_rule.foo"
+	rName := acctest.RandString(5)
+	config := testAccNewRelicAlertMutingRuleWithSchedule(
+		rName,
+		"weekly muting rule",
+		"product",
+		"EQUALS",
+		"APM",
+		`
+			start_time         = "2021-01-21T15:30:00"
+			end_time           = "2021-01-21T16:30:00"
+			time_zone          = "Europe/Berlin"
+			repeat             = "WEEKLY"
+			repeat_count       = 10
+			weekly_repeat_days = ["MONDAY", "THURSDAY"]
+		`,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicAlertMutingRuleDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicAlertMutingRuleExists(resourceName),
+				),
+			},
+			// Test: Import rebuilds the schedule and conditions
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Test: No changes after import
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccNewRelicAlertMutingRuleBasic(
 	name string,
 	description string,
@@ -239,6 +326,25 @@ resource "newrelic_alert_muting_rule" "foo" {
 `, name, description, attribute, operator, values)
 }
 
+func testAccNewRelicAlertMutingRuleEnabled(name string, enabled bool) string {
+	return fmt.Sprintf(`
+
+resource "newrelic_alert_muting_rule" "foo" {
+	name = "tf-test-%[1]s"
+	enabled = %[2]t
+	description = "muting rule toggled in-place"
+	condition {
+		conditions {
+			attribute 	= "product"
+			operator 	= "EQUALS"
+			values 		= ["APM"]
+		}
+		operator = "AND"
+	}
+}
+`, name, enabled)
+}
+
 func testAccNewRelicAlertMutingRuleBadInput(
 	name string,
 	description string,
@@ -347,6 +453,27 @@ func testAccCheckNewRelicAlertMutingRuleExists(n string) resource.TestCheckFunc
 	}
 }
 
+// Stores the muting rule ID on the first call and verifies it is unchanged on subsequent calls.
+func testAccCheckNewRelicAlertMutingRuleSameID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if *id == "" {
+			*id = rs.Primary.ID
+			return nil
+		}
+
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("expected muting rule to be updated in-place, but ID changed from %s to %s", *id, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNewRelicAlertMutingRuleDestroy(s *terraform.State) error {
 	providerConfig := testAccProvider.Meta().(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -4,10 +4,16 @@
 package newrelic
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccNewRelicAlertPolicy_ErrorThrownWhenNameEmpty(t *testing.T) {
@@ -26,3 +32,64 @@ func TestAccNewRelicAlertPolicy_ErrorThrownWhenNameEmpty(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceNewRelicAlertPolicyRead_QueriesResourceAccount(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		id                string
+		resourceAccountID int
+		expectedAccountID int
+	}{
+		"resource account differs from provider default": {
+			id:                "123",
+			resourceAccountID: 2000,
+			expectedAccountID: 2000,
+		},
+		"account from imported id": {
+			id:                "123:3000",
+			resourceAccountID: 3000,
+			expectedAccountID: 3000,
+		},
+		"no resource account": {
+			id:                "123",
+			expectedAccountID: 1000,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var queriedAccountID int
+			providerConfig := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Variables struct {
+						AccountID int    `json:"accountID"`
+						PolicyID  string `json:"policyID"`
+					} `json:"variables"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "123", body.Variables.PolicyID)
+				queriedAccountID = body.Variables.AccountID
+
+				_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"alerts":{"policy":{"id":"123","name":"policy","incidentPreference":"PER_CONDITION"}}}}}}`))
+			})
+			providerConfig.AccountID = 1000
+
+			state := map[string]interface{}{}
+			if tc.resourceAccountID != 0 {
+				state["account_id"] = tc.resourceAccountID
+			}
+			d := schema.TestResourceDataRaw(t, resourceNewRelicAlertPolicy().Schema, state)
+			d.SetId(tc.id)
+
+			require.False(t, resourceNewRelicAlertPolicyRead(context.Background(), d, providerConfig).HasError())
+
+			require.Equal(t, tc.expectedAccountID, queriedAccountID)
+			require.Equal(t, tc.expectedAccountID, d.Get("account_id"))
+			require.Equal(t, "PER_CONDITION", d.Get("incident_preference"))
+		})
+	}
+}
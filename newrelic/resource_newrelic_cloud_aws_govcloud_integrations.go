@@ -31,6 +31,7 @@ func resourceNewRelicAwsGovCloudIntegrations() *schema.Resource {
 				Required:    true,
 				Description: "The ID of the linked AwsGovCloud account in New Relic",
 			},
+			"integration_status": cloudIntegrationStatusSchema(),
 
 			// list of resources in AwsGov cloud for integrations
 
@@ -791,27 +792,19 @@ func resourceNewRelicAwsGovCloudIntegrationsCreate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(awsGovCloudIntegrationsPayload.Errors) > 0 {
-		for _, err := range awsGovCloudIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
-
+	// Track the services that were configured, even when others failed
 	if len(awsGovCloudIntegrationsPayload.Integrations) > 0 {
 		d.SetId(strconv.Itoa(d.Get("linked_account_id").(int)))
 	}
 
-	return nil
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, awsGovCloudIntegrationsPayload.Errors)
+	if diags.HasError() && d.Id() != "" {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewRelicAwsGovCloudIntegrations())...)
+	}
+
+	return diags
 }
 
-// TODO: Reduce the cyclomatic complexity of this func
-// nolint: gocyclo
 func expandAwsGovCloudIntegrationsInput(d *schema.ResourceData) (cloud.CloudIntegrationsInput, cloud.CloudDisableIntegrationsInput) {
 	awsGovCloudIntegration := cloud.CloudAwsGovcloudIntegrationsInput{}
 	cloudDisableAwsGovCloudIntegration := cloud.CloudAwsGovcloudDisableIntegrationsInput{}
@@ -821,107 +814,171 @@ func expandAwsGovCloudIntegrationsInput(d *schema.ResourceData) (cloud.CloudInte
 	if l, ok := d.GetOk("linked_account_id"); ok {
 		linkedAccountID = l.(int)
 	}
-	if v, ok := d.GetOk("alb"); ok {
-		awsGovCloudIntegration.Alb = expandAwsGovCloudIntegrationsAlbInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("alb"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Alb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("api_gateway"); ok {
-		awsGovCloudIntegration.APIgateway = expandAwsGovCloudIntegrationsAPIGatewayInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("api_gateway"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.APIgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("auto_scaling"); ok {
-		awsGovCloudIntegration.Autoscaling = expandAwsGovCloudIntegrationsAutoScalingInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("auto_scaling"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Autoscaling = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("aws_direct_connect"); ok {
-		awsGovCloudIntegration.AwsDirectconnect = expandAwsGovCloudIntegrationsAwsDirectConnectInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("aws_direct_connect"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.AwsDirectconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("aws_states"); ok {
-		awsGovCloudIntegration.AwsStates = expandAwsGovCloudIntegrationsAwsStatesInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("aws_states"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.AwsStates = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("cloudtrail"); ok {
-		awsGovCloudIntegration.Cloudtrail = expandAwsGovCloudIntegrationsCloudtrailInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("cloudtrail"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Cloudtrail = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("dynamo_db"); ok {
-		awsGovCloudIntegration.Dynamodb = expandAwsGovCloudIntegrationsDynamodbInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("dynamo_db"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Dynamodb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("ebs"); ok {
-		awsGovCloudIntegration.Ebs = expandAwsGovCloudIntegrationsEbsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("ebs"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Ebs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("ec2"); ok {
-		awsGovCloudIntegration.Ec2 = expandAwsGovCloudIntegrationsEc2Input(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("ec2"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Ec2 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("elastic_search"); ok {
-		awsGovCloudIntegration.Elasticsearch = expandAwsGovCloudIntegrationsElasticsearchInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("elastic_search"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Elasticsearch = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("elb"); ok {
-		awsGovCloudIntegration.Elb = expandAwsGovCloudIntegrationsElbInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("elb"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Elb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("emr"); ok {
-		awsGovCloudIntegration.Emr = expandAwsGovCloudIntegrationsEmrInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("emr"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Emr = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("iam"); ok {
-		awsGovCloudIntegration.Iam = expandAwsGovCloudIntegrationsIamInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("iam"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Iam = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("lambda"); ok {
-		awsGovCloudIntegration.Lambda = expandAwsGovCloudIntegrationsLambdaInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("lambda"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Lambda = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("rds"); ok {
-		awsGovCloudIntegration.Rds = expandAwsGovCloudIntegrationsRdsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("rds"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Rds = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("red_shift"); ok {
-		awsGovCloudIntegration.Redshift = expandAwsGovCloudIntegrationsRedshiftInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("red_shift"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Redshift = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("route53"); ok {
-		awsGovCloudIntegration.Route53 = expandAwsGovCloudIntegrationsRoute53Input(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("route53"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Route53 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("s3"); ok {
-		awsGovCloudIntegration.S3 = expandAwsGovCloudIntegrationsS3Input(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("s3"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.S3 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("sns"); ok {
-		awsGovCloudIntegration.Sns = expandAwsGovCloudIntegrationsSnsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("sns"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Sns = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("sqs"); ok {
-		awsGovCloudIntegration.Sqs = expandAwsGovCloudIntegrationsSqsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("sqs"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Sqs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	integrationSpecs := map[string]cloudIntegrationSpec{
+		"alb": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Alb = expandAwsGovCloudIntegrationsAlbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Alb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"api_gateway": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.APIgateway = expandAwsGovCloudIntegrationsAPIGatewayInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.APIgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"auto_scaling": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Autoscaling = expandAwsGovCloudIntegrationsAutoScalingInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Autoscaling = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_direct_connect": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.AwsDirectconnect = expandAwsGovCloudIntegrationsAwsDirectConnectInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.AwsDirectconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_states": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.AwsStates = expandAwsGovCloudIntegrationsAwsStatesInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.AwsStates = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"cloudtrail": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Cloudtrail = expandAwsGovCloudIntegrationsCloudtrailInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Cloudtrail = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"dynamo_db": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Dynamodb = expandAwsGovCloudIntegrationsDynamodbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Dynamodb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"ebs": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Ebs = expandAwsGovCloudIntegrationsEbsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Ebs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"ec2": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Ec2 = expandAwsGovCloudIntegrationsEc2Input(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Ec2 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"elastic_search": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Elasticsearch = expandAwsGovCloudIntegrationsElasticsearchInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Elasticsearch = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"elb": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Elb = expandAwsGovCloudIntegrationsElbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Elb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"emr": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Emr = expandAwsGovCloudIntegrationsEmrInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Emr = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"iam": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Iam = expandAwsGovCloudIntegrationsIamInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Iam = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"lambda": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Lambda = expandAwsGovCloudIntegrationsLambdaInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Lambda = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"rds": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Rds = expandAwsGovCloudIntegrationsRdsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Rds = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"red_shift": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Redshift = expandAwsGovCloudIntegrationsRedshiftInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Redshift = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"route53": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Route53 = expandAwsGovCloudIntegrationsRoute53Input(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Route53 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"s3": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.S3 = expandAwsGovCloudIntegrationsS3Input(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.S3 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sns": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Sns = expandAwsGovCloudIntegrationsSnsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Sns = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sqs": {
+			enableFunc: func(a []interface{}, id int) {
+				awsGovCloudIntegration.Sqs = expandAwsGovCloudIntegrationsSqsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAwsGovCloudIntegration.Sqs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
 	}
 
+	reconcileCloudIntegrations(d, linkedAccountID, integrationSpecs)
+
 	configureInput := cloud.CloudIntegrationsInput{
 		AwsGovcloud: awsGovCloudIntegration,
 	}
@@ -1838,6 +1895,7 @@ func resourceNewRelicAwsGovCloudIntegrationsRead(ctx context.Context, d *schema.
 func flattenAwsGovCloudLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAccount) {
 	_ = d.Set("account_id", result.NrAccountId)
 	_ = d.Set("linked_account_id", result.ID)
+	_ = d.Set("integration_status", flattenCloudIntegrationStatus(result.Integrations))
 
 	for _, i := range result.Integrations {
 		switch t := i.(type) {
@@ -2240,31 +2298,20 @@ func resourceNewRelicAwsGovCloudIntegrationsUpdate(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
+	// A service failing doesn't stop the other services from being applied
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, awsGovCloudDisablePayload.Errors)
 
-	if len(awsGovCloudDisablePayload.Errors) > 0 {
-		for _, err := range awsGovCloudDisablePayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
 	awsGovCloudIntegrationPayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, integrateInput)
 	if err != nil {
-		return diag.FromErr(err)
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		diags = append(diags, buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, awsGovCloudIntegrationPayload.Errors)...)
 	}
 
-	if len(awsGovCloudIntegrationPayload.Errors) > 0 {
-		for _, err := range awsGovCloudIntegrationPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
+	if diags.HasError() {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewRelicAwsGovCloudIntegrations())...)
 	}
+
 	return nil
 }
 
@@ -2279,15 +2326,7 @@ func resourceNewRelicAwsGovCloudIntegrationsDelete(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(awsGovCloudDisablePayload.Errors) > 0 {
-		for _, err := range awsGovCloudDisablePayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
+	if diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, awsGovCloudDisablePayload.Errors); diags.HasError() {
 		return diags
 	}
 
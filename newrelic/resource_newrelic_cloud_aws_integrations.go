@@ -30,6 +30,7 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Required:    true,
 				Description: "The ID of the linked AWS account in New Relic",
 			},
+			"integration_status": cloudIntegrationStatusSchema(),
 			"billing": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -422,7 +423,7 @@ func cloudAwsIntegrationSchemaBaseExtended() map[string]*schema.Schema {
 
 	return map[string]*schema.Schema{
 		"aws_regions": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "Specify each AWS region that includes the resources that you want to monitor.",
 			Elem: &schema.Schema{
@@ -441,7 +442,7 @@ func cloudAwsIntegrationSchemaBaseExtendedOne() map[string]*schema.Schema {
 
 	return map[string]*schema.Schema{
 		"aws_regions": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "Specify each AWS region that includes the resources that you want to monitor.",
 			Elem: &schema.Schema{
@@ -487,7 +488,7 @@ func cloudAwsIntegrationCloudTrailSchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -560,7 +561,7 @@ func cloudAwsIntegrationVpcSchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -603,7 +604,7 @@ func cloudAwsIntegrationXRaySchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -622,7 +623,7 @@ func cloudAwsIntegrationSqsSchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -669,7 +670,7 @@ func cloudAwsIntegrationEbsSchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -703,7 +704,7 @@ func cloudAwsIntegrationAlbSchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -750,7 +751,7 @@ func cloudAwsIntegrationElasticacheSchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Optional:    true,
 		Description: "Specify each AWS region that includes the resources that you want to monitor.",
 		Elem: &schema.Schema{
@@ -783,7 +784,7 @@ func cloudAwsIntegrationElasticacheSchemaElem() *schema.Resource {
 func cloudAwsIntegrationAPIGatewaySchemaElem() *schema.Resource {
 	s := cloudAwsIntegrationSchemaBase()
 	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 		Description: "Specify each AWS region that includes the resources that you want to monitor",
 		Optional:    true,
 		Elem: &schema.Schema{
@@ -1107,23 +1108,17 @@ func resourceNewRelicCloudAwsIntegrationsCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(cloudAwsIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudAwsIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
-
+	// Track the services that were configured, even when others failed
 	if len(cloudAwsIntegrationsPayload.Integrations) > 0 {
 		d.SetId(strconv.Itoa(d.Get("linked_account_id").(int)))
 	}
 
-	return nil
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, cloudAwsIntegrationsPayload.Errors)
+	if diags.HasError() && d.Id() != "" {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewRelicCloudAwsIntegrations())...)
+	}
+
+	return diags
 }
 
 func resourceNewRelicCloudAwsIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1161,38 +1156,24 @@ func resourceNewRelicCloudAwsIntegrationsUpdate(ctx context.Context, d *schema.R
 	configureInput, disableInput := expandCloudAwsIntegrationsInput(d)
 
 	cloudDisableIntegrationsPayload, err := client.Cloud.CloudDisableIntegrationWithContext(ctx, accountID, disableInput)
-
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(cloudDisableIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudDisableIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
+	// A service failing doesn't stop the other services from being applied
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, cloudDisableIntegrationsPayload.Errors)
 
 	cloudAwsIntegrationsPayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, configureInput)
-
 	if err != nil {
-		return diag.FromErr(err)
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		diags = append(diags, buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, cloudAwsIntegrationsPayload.Errors)...)
 	}
 
-	if len(cloudAwsIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudAwsIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
+	if diags.HasError() {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewRelicCloudAwsIntegrations())...)
 	}
+
 	return nil
 }
 
@@ -1210,15 +1191,7 @@ func resourceNewRelicCloudAwsIntegrationsDelete(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(cloudDisableIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudDisableIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
+	if diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, cloudDisableIntegrationsPayload.Errors); diags.HasError() {
 		return diags
 	}
 
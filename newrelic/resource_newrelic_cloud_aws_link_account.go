@@ -3,6 +3,7 @@ package newrelic
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ func resourceNewRelicCloudAwsAccountLinkAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNewRelicCloudAwsAccountLinkCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -47,6 +49,11 @@ func resourceNewRelicCloudAwsAccountLinkAccount() *schema.Resource {
 				Description: "The name of the linked account.",
 				Required:    true,
 			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The external ID of the linked AWS account, as returned by New Relic.",
+				Computed:    true,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Second),
@@ -54,6 +61,37 @@ func resourceNewRelicCloudAwsAccountLinkAccount() *schema.Resource {
 	}
 }
 
+// Matches the ARN of an IAM role, in any AWS partition, e.g.
+// arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations.
+var awsLinkAccountRoleARNRegex = regexp.MustCompile(`^arn:aws(-[a-z]+)*:iam::\d{12}:role/.+$`)
+
+func resourceNewRelicCloudAwsAccountLinkCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("arn") || !diff.NewValueKnown("metric_collection_mode") {
+		return nil
+	}
+
+	return validateAwsLinkAccountARN(diff.Get("arn").(string), diff.Get("metric_collection_mode").(string))
+}
+
+// validateAwsLinkAccountARN checks that the ARN New Relic assumes to link the account is an IAM
+// role ARN. Both collection modes need it: PULL polls the AWS APIs with the role, and PUSH uses it
+// to enrich the metric stream with inventory data.
+func validateAwsLinkAccountARN(arn string, metricCollectionMode string) error {
+	if metricCollectionMode == "" {
+		metricCollectionMode = "PULL"
+	}
+
+	if strings.TrimSpace(arn) == "" {
+		return fmt.Errorf("arn is required when metric_collection_mode is %s", metricCollectionMode)
+	}
+
+	if !awsLinkAccountRoleARNRegex.MatchString(arn) {
+		return fmt.Errorf("arn must be the ARN of an IAM role when metric_collection_mode is %s, e.g. arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations, got %s", metricCollectionMode, arn)
+	}
+
+	return nil
+}
+
 func resourceNewRelicCloudAwsAccountLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -84,6 +122,7 @@ func resourceNewRelicCloudAwsAccountLinkCreate(ctx context.Context, d *schema.Re
 
 		if len(cloudLinkAccountPayload.LinkedAccounts) > 0 {
 			d.SetId(strconv.Itoa(cloudLinkAccountPayload.LinkedAccounts[0].ID))
+			_ = d.Set("external_id", cloudLinkAccountPayload.LinkedAccounts[0].ExternalId)
 		}
 
 		return nil
@@ -153,6 +192,7 @@ func readAwsLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAccou
 	_ = d.Set("arn", result.AuthLabel)
 	_ = d.Set("metric_collection_mode", result.MetricCollectionMode)
 	_ = d.Set("name", result.Name)
+	_ = d.Set("external_id", result.ExternalId)
 }
 
 func resourceNewRelicCloudAwsAccountLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -168,7 +208,7 @@ func resourceNewRelicCloudAwsAccountLinkUpdate(ctx context.Context, d *schema.Re
 	}
 	cloudRenameAccountPayload, err := client.Cloud.CloudRenameAccountWithContext(ctx, accountID, input)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 
 	var diags diag.Diagnostics
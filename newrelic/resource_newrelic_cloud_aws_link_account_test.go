@@ -44,6 +44,7 @@ func TestAccNewRelicCloudAwsLinkAccount_Basic(t *testing.T) {
 				Config: testAccNewRelicAwsLinkAccountConfig(AWSLinkAccountTestConfig, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicCloudAwsLinkAccountExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "external_id"),
 				),
 			},
 			//Test: Update
@@ -0,0 +1,102 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadAwsLinkedAccount_ExternalID(t *testing.T) {
+	d := resourceNewRelicCloudAwsAccountLinkAccount().TestResourceData()
+
+	readAwsLinkedAccount(d, &cloud.CloudLinkedAccount{
+		NrAccountId:          123,
+		AuthLabel:            "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+		MetricCollectionMode: cloud.CloudMetricCollectionModeTypes.PULL,
+		Name:                 "linked account",
+		ExternalId:           "123456789012",
+	})
+
+	require.Equal(t, "123456789012", d.Get("external_id"))
+	require.Equal(t, "linked account", d.Get("name"))
+	require.Equal(t, 123, d.Get("account_id"))
+}
+
+func TestValidateAwsLinkAccountARN(t *testing.T) {
+	cases := map[string]struct {
+		arn                  string
+		metricCollectionMode string
+		expectedErr          string
+	}{
+		"pull role": {
+			arn:                  "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+			metricCollectionMode: "PULL",
+		},
+		"push role": {
+			arn:                  "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+			metricCollectionMode: "PUSH",
+		},
+		"default mode govcloud role": {
+			arn: "arn:aws-us-gov:iam::123456789012:role/path/NewRelic",
+		},
+		"pull missing arn": {
+			arn:                  "",
+			metricCollectionMode: "PULL",
+			expectedErr:          "arn is required when metric_collection_mode is PULL",
+		},
+		"default mode missing arn": {
+			arn:         " ",
+			expectedErr: "arn is required when metric_collection_mode is PULL",
+		},
+		"push missing arn": {
+			arn:                  "",
+			metricCollectionMode: "PUSH",
+			expectedErr:          "arn is required when metric_collection_mode is PUSH",
+		},
+		"pull user arn": {
+			arn:                  "arn:aws:iam::123456789012:user/newrelic",
+			metricCollectionMode: "PULL",
+			expectedErr:          "arn must be the ARN of an IAM role when metric_collection_mode is PULL, e.g. arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations, got arn:aws:iam::123456789012:user/newrelic",
+		},
+		"push metric stream arn": {
+			arn:                  "arn:aws:cloudwatch:us-east-1:123456789012:metric-stream/NewRelic",
+			metricCollectionMode: "PUSH",
+			expectedErr:          "arn must be the ARN of an IAM role when metric_collection_mode is PUSH, e.g. arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations, got arn:aws:cloudwatch:us-east-1:123456789012:metric-stream/NewRelic",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateAwsLinkAccountARN(tc.arn, tc.metricCollectionMode)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestCloudAwsLinkAccountCustomizeDiff(t *testing.T) {
+	r := resourceNewRelicCloudAwsAccountLinkAccount()
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"arn":                    "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+		"metric_collection_mode": "PUSH",
+		"name":                   "linked account",
+	}), nil)
+	require.NoError(t, err)
+
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"arn":  "123456789012",
+		"name": "linked account",
+	}), nil)
+	require.EqualError(t, err, "arn must be the ARN of an IAM role when metric_collection_mode is PULL, e.g. arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations, got 123456789012")
+}
@@ -31,6 +31,7 @@ func resourceNewRelicCloudAzureIntegrations() *schema.Resource {
 				Required:    true,
 				Description: "The ID of the linked Azure account in New Relic",
 			},
+			"integration_status": cloudIntegrationStatusSchema(),
 
 			// List of Integrations with Azure
 
@@ -870,29 +871,21 @@ func resourceNewRelicCloudAzureIntegrationsCreate(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(cloudAzureIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudAzureIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
-
+	// Track the services that were configured, even when others failed
 	if len(cloudAzureIntegrationsPayload.Integrations) > 0 {
 		d.SetId(strconv.Itoa(d.Get("linked_account_id").(int)))
 	}
 
-	return nil
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, cloudAzureIntegrationsPayload.Errors)
+	if diags.HasError() && d.Id() != "" {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewRelicCloudAzureIntegrations())...)
+	}
+
+	return diags
 }
 
 // expand function to extract inputs from the schema.
 // It takes ResourceData as input and returns CloudDisableIntegrationsInput.
-// TODO: Reduce the cyclomatic complexity of this func
-// nolint: gocyclo
 func expandCloudAzureIntegrationsInput(d *schema.ResourceData) (cloud.CloudIntegrationsInput, cloud.CloudDisableIntegrationsInput) {
 	cloudAzureIntegration := cloud.CloudAzureIntegrationsInput{}
 	cloudDisableAzureIntegration := cloud.CloudAzureDisableIntegrationsInput{}
@@ -902,195 +895,266 @@ func expandCloudAzureIntegrationsInput(d *schema.ResourceData) (cloud.CloudInteg
 	if l, ok := d.GetOk("linked_account_id"); ok {
 		linkedAccountID = l.(int)
 	}
-	if v, ok := d.GetOk("api_management"); ok {
-		cloudAzureIntegration.AzureAPImanagement = expandCloudAzureIntegrationAPIManagementInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("api_management"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureAPImanagement = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("app_gateway"); ok {
-		cloudAzureIntegration.AzureAppgateway = expandCloudAzureIntegrationAppGatewayInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("app_gateway"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureAppgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("app_service"); ok {
-		cloudAzureIntegration.AzureAppservice = expandCloudAzureIntegrationAppServiceInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("app_service"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureAppservice = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("containers"); ok {
-		cloudAzureIntegration.AzureContainers = expandCloudAzureIntegrationContainersInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("containers"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureContainers = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("cosmos_db"); ok {
-		cloudAzureIntegration.AzureCosmosdb = expandCloudAzureIntegrationCosmosdbInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("cosmos_db"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureCosmosdb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("cost_management"); ok {
-		cloudAzureIntegration.AzureCostmanagement = expandCloudAzureIntegrationCostManagementInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("cost_management"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureCostmanagement = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("data_factory"); ok {
-		cloudAzureIntegration.AzureDatafactory = expandCloudAzureIntegrationDataFactoryInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("data_factory"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureDatafactory = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("event_hub"); ok {
-		cloudAzureIntegration.AzureEventhub = expandCloudAzureIntegrationCloudEventHubInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("event_hub"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureEventhub = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("express_route"); ok {
-		cloudAzureIntegration.AzureExpressroute = expandCloudAzureIntegrationExpressRouteInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("express_route"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureExpressroute = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("firewalls"); ok {
-		cloudAzureIntegration.AzureFirewalls = expandCloudAzureIntegrationFirewallsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("firewalls"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureFirewalls = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("front_door"); ok {
-		cloudAzureIntegration.AzureFrontdoor = expandCloudAzureIntegrationFrontDoorInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("front_door"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureFrontdoor = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("functions"); ok {
-		cloudAzureIntegration.AzureFunctions = expandCloudAzureIntegrationFunctionsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("functions"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureFunctions = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("key_vault"); ok {
-		cloudAzureIntegration.AzureKeyvault = expandCloudAzureIntegrationKeyVaultInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("key_vault"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureKeyvault = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("load_balancer"); ok {
-		cloudAzureIntegration.AzureLoadbalancer = expandCloudAzureIntegrationLoadBalancerInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("load_balancer"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureLoadbalancer = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("logic_apps"); ok {
-		cloudAzureIntegration.AzureLogicapps = expandCloudAzureIntegrationLogicAppsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("logic_apps"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureLogicapps = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	//
-	if v, ok := d.GetOk("machine_learning"); ok {
-		cloudAzureIntegration.AzureMachinelearning = expandCloudAzureIntegrationMachineLearningInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("machine_learning"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureMachinelearning = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("maria_db"); ok {
-		cloudAzureIntegration.AzureMariadb = expandCloudAzureIntegrationMariadbInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("maria_db"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureMariadb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("monitor"); ok {
-		cloudAzureIntegration.AzureMonitor = expandCloudAzureIntegrationMonitorInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("monitor"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureMonitor = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("mysql"); ok {
-		cloudAzureIntegration.AzureMysql = expandCloudAzureIntegrationMysqlInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("mysql"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureMysql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("mysql_flexible"); ok {
-		cloudAzureIntegration.AzureMysqlflexible = expandCloudAzureIntegrationMysqlFlexibleInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("mysql_flexible"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureMysqlflexible = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("postgresql"); ok {
-		cloudAzureIntegration.AzurePostgresql = expandCloudAzureIntegrationPostgresqlInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("postgresql"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzurePostgresql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("postgresql_flexible"); ok {
-		cloudAzureIntegration.AzurePostgresqlflexible = expandCloudAzureIntegrationPostgresqlFlexibleInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("postgresql_flexible"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzurePostgresqlflexible = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("power_bi_dedicated"); ok {
-		cloudAzureIntegration.AzurePowerbidedicated = expandCloudAzureIntegrationPowerBiDedicatedInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("power_bi_dedicated"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzurePowerbidedicated = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("redis_cache"); ok {
-		cloudAzureIntegration.AzureRediscache = expandCloudAzureIntegrationRedisCacheInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("redis_cache"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureRediscache = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("service_bus"); ok {
-		cloudAzureIntegration.AzureServicebus = expandCloudAzureIntegrationServiceBusInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("service_bus"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureServicebus = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("sql"); ok {
-		cloudAzureIntegration.AzureSql = expandCloudAzureIntegrationSQLInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("sql"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureSql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("sql_managed"); ok {
-		cloudAzureIntegration.AzureSqlmanaged = expandCloudAzureIntegrationSQLManagedInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("sql_managed"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureSqlmanaged = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("storage"); ok {
-		cloudAzureIntegration.AzureStorage = expandCloudAzureIntegrationStorageInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("storage"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureStorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("virtual_machine"); ok {
-		cloudAzureIntegration.AzureVirtualmachine = expandCloudAzureIntegrationVirtualMachineInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("virtual_machine"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureVirtualmachine = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("virtual_networks"); ok {
-		cloudAzureIntegration.AzureVirtualnetworks = expandCloudAzureIntegrationVirtualNetworksInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("virtual_networks"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureVirtualnetworks = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("vms"); ok {
-		cloudAzureIntegration.AzureVms = expandCloudAzureIntegrationVmsInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("vms"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureVms = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	integrationSpecs := map[string]cloudIntegrationSpec{
+		"api_management": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureAPImanagement = expandCloudAzureIntegrationAPIManagementInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureAPImanagement = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"app_gateway": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureAppgateway = expandCloudAzureIntegrationAppGatewayInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureAppgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"app_service": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureAppservice = expandCloudAzureIntegrationAppServiceInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureAppservice = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"containers": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureContainers = expandCloudAzureIntegrationContainersInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureContainers = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"cosmos_db": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureCosmosdb = expandCloudAzureIntegrationCosmosdbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureCosmosdb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"cost_management": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureCostmanagement = expandCloudAzureIntegrationCostManagementInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureCostmanagement = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"data_factory": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureDatafactory = expandCloudAzureIntegrationDataFactoryInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureDatafactory = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"event_hub": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureEventhub = expandCloudAzureIntegrationCloudEventHubInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureEventhub = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"express_route": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureExpressroute = expandCloudAzureIntegrationExpressRouteInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureExpressroute = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"firewalls": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureFirewalls = expandCloudAzureIntegrationFirewallsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureFirewalls = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"front_door": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureFrontdoor = expandCloudAzureIntegrationFrontDoorInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureFrontdoor = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"functions": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureFunctions = expandCloudAzureIntegrationFunctionsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureFunctions = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"key_vault": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureKeyvault = expandCloudAzureIntegrationKeyVaultInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureKeyvault = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"load_balancer": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureLoadbalancer = expandCloudAzureIntegrationLoadBalancerInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureLoadbalancer = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"logic_apps": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureLogicapps = expandCloudAzureIntegrationLogicAppsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureLogicapps = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"machine_learning": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureMachinelearning = expandCloudAzureIntegrationMachineLearningInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureMachinelearning = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"maria_db": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureMariadb = expandCloudAzureIntegrationMariadbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureMariadb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"monitor": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureMonitor = expandCloudAzureIntegrationMonitorInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureMonitor = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"mysql": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureMysql = expandCloudAzureIntegrationMysqlInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureMysql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"mysql_flexible": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureMysqlflexible = expandCloudAzureIntegrationMysqlFlexibleInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureMysqlflexible = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"postgresql": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzurePostgresql = expandCloudAzureIntegrationPostgresqlInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzurePostgresql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"postgresql_flexible": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzurePostgresqlflexible = expandCloudAzureIntegrationPostgresqlFlexibleInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzurePostgresqlflexible = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"power_bi_dedicated": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzurePowerbidedicated = expandCloudAzureIntegrationPowerBiDedicatedInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzurePowerbidedicated = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"redis_cache": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureRediscache = expandCloudAzureIntegrationRedisCacheInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureRediscache = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"service_bus": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureServicebus = expandCloudAzureIntegrationServiceBusInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureServicebus = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sql": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureSql = expandCloudAzureIntegrationSQLInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureSql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sql_managed": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureSqlmanaged = expandCloudAzureIntegrationSQLManagedInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureSqlmanaged = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"storage": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureStorage = expandCloudAzureIntegrationStorageInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureStorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"virtual_machine": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureVirtualmachine = expandCloudAzureIntegrationVirtualMachineInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureVirtualmachine = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"virtual_networks": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureVirtualnetworks = expandCloudAzureIntegrationVirtualNetworksInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureVirtualnetworks = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"vms": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureVms = expandCloudAzureIntegrationVmsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureVms = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"vpn_gateway": {
+			enableFunc: func(a []interface{}, id int) {
+				cloudAzureIntegration.AzureVpngateways = expandCloudAzureIntegrationVpnGatewayInput(a, id)
+			},
+			disableFunc: func(id int) {
+				cloudDisableAzureIntegration.AzureVpngateways = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
 	}
 
-	if v, ok := d.GetOk("vpn_gateway"); ok {
-		cloudAzureIntegration.AzureVpngateways = expandCloudAzureIntegrationVpnGatewayInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("vpn_gateway"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAzureIntegration.AzureVpngateways = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
+	reconcileCloudIntegrations(d, linkedAccountID, integrationSpecs)
 
 	configureInput := cloud.CloudIntegrationsInput{
 		Azure: cloudAzureIntegration,
@@ -2318,6 +2382,7 @@ func resourceNewRelicCloudAzureIntegrationsRead(ctx context.Context, d *schema.R
 func flattenCloudAzureLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAccount) {
 	_ = d.Set("account_id", result.NrAccountId)
 	_ = d.Set("linked_account_id", result.ID)
+	_ = d.Set("integration_status", flattenCloudIntegrationStatus(result.Integrations))
 
 	for _, i := range result.Integrations {
 		switch t := i.(type) {
@@ -2883,31 +2948,20 @@ func resourceNewRelicCloudAzureIntegrationsUpdate(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
+	// A service failing doesn't stop the other services from being applied
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, azureDisablePayload.Errors)
 
-	if len(azureDisablePayload.Errors) > 0 {
-		for _, err := range azureDisablePayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
 	azureIntegrationPayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, integrateInput)
 	if err != nil {
-		return diag.FromErr(err)
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		diags = append(diags, buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, azureIntegrationPayload.Errors)...)
 	}
 
-	if len(azureIntegrationPayload.Errors) > 0 {
-		for _, err := range azureIntegrationPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
+	if diags.HasError() {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewRelicCloudAzureIntegrations())...)
 	}
+
 	return nil
 }
 
@@ -2922,15 +2976,7 @@ func resourceNewRelicCloudAzureIntegrationsDelete(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(azureDisablePayload.Errors) > 0 {
-		for _, err := range azureDisablePayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
+	if diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, azureDisablePayload.Errors); diags.HasError() {
 		return diags
 	}
 
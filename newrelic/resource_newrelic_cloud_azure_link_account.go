@@ -2,11 +2,14 @@ package newrelic
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
 )
 
@@ -19,6 +22,7 @@ func resourceNewRelicCloudAzureLinkAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNewRelicCloudAzureLinkAccountCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -56,6 +60,44 @@ func resourceNewRelicCloudAzureLinkAccount() *schema.Resource {
 	}
 }
 
+// The credentials of the Azure service principal, all of which are needed to link the account.
+var azureLinkAccountCredentialAttributes = []string{"application_id", "tenant_id", "subscription_id", "client_secret"}
+
+func resourceNewRelicCloudAzureLinkAccountCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	credentials := make(map[string]string, len(azureLinkAccountCredentialAttributes))
+	for _, attr := range azureLinkAccountCredentialAttributes {
+		if !diff.NewValueKnown(attr) {
+			return nil
+		}
+		credentials[attr] = diff.Get(attr).(string)
+	}
+
+	return validateAzureLinkAccountCredentials(credentials)
+}
+
+// validateAzureLinkAccountCredentials checks that every credential is set and that the Azure
+// identifiers are GUIDs.
+func validateAzureLinkAccountCredentials(credentials map[string]string) error {
+	var missing []string
+	for _, attr := range azureLinkAccountCredentialAttributes {
+		if strings.TrimSpace(credentials[attr]) == "" {
+			missing = append(missing, attr)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s must be configured together, missing: %s", strings.Join(azureLinkAccountCredentialAttributes, ", "), strings.Join(missing, ", "))
+	}
+
+	for _, attr := range []string{"application_id", "tenant_id", "subscription_id"} {
+		if _, errs := validation.IsUUID(credentials[attr], attr); len(errs) > 0 {
+			return errs[0]
+		}
+	}
+
+	return nil
+}
+
 func resourceNewRelicCloudAzureLinkAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -139,9 +181,12 @@ func resourceNewRelicCloudAzureLinkAccountRead(ctx context.Context, d *schema.Re
 	return nil
 }
 
+// The client secret and tenant ID are not returned by the API and are preserved from state.
 func readAzureLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAccount) {
 	_ = d.Set("account_id", result.NrAccountId)
 	_ = d.Set("name", result.Name)
+	_ = d.Set("application_id", result.AuthLabel)
+	_ = d.Set("subscription_id", result.ExternalId)
 }
 
 func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -149,6 +194,26 @@ func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.
 	client := providerConfig.NewClient
 	accountID := selectAccountID(providerConfig, d)
 	id, _ := strconv.Atoi(d.Id())
+
+	// Credential changes (e.g. a rotated client secret) require the linked account
+	// to re-authenticate, which a rename cannot do.
+	if d.HasChanges("application_id", "client_secret", "subscription_id", "tenant_id") {
+		log.Printf("[INFO] Updating credentials of Azure linked account %d", id)
+
+		input := expandAzureCloudUpdateAccountInput(d, id)
+		resp := cloudUpdateAccountResponse{}
+		vars := map[string]interface{}{
+			"accountId": accountID,
+			"accounts":  input,
+		}
+
+		if err := client.NerdGraph.QueryWithResponseAndContext(ctx, cloudUpdateAccountMutation, vars, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceNewRelicCloudAzureLinkAccountRead(ctx, d, meta)
+	}
+
 	input := []cloud.CloudRenameAccountsInput{
 		{
 			Name:            d.Get("name").(string),
@@ -158,8 +223,7 @@ func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.
 	cloudRenameAccountPayload, err := client.Cloud.CloudRenameAccountWithContext(ctx, accountID, input)
 
 	if err != nil {
-
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 
 	var diags diag.Diagnostics
@@ -175,7 +239,69 @@ func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.
 
 		return diags
 	}
-	return nil
+	return resourceNewRelicCloudAzureLinkAccountRead(ctx, d, meta)
+}
+
+// cloudAzureUpdateAccountInput mirrors the NerdGraph CloudAzureUpdateAccountInput type,
+// which is not yet available in newrelic-client-go.
+type cloudAzureUpdateAccountInput struct {
+	ApplicationID   string            `json:"applicationId,omitempty"`
+	ClientSecret    cloud.SecureValue `json:"clientSecret,omitempty"`
+	LinkedAccountId int               `json:"linkedAccountId"`
+	Name            string            `json:"name,omitempty"`
+	SubscriptionId  string            `json:"subscriptionId,omitempty"`
+	TenantId        string            `json:"tenantId,omitempty"`
+}
+
+type cloudUpdateCloudAccountsInput struct {
+	Azure []cloudAzureUpdateAccountInput `json:"azure,omitempty"`
+}
+
+type cloudUpdateAccountResponse struct {
+	CloudUpdateAccount struct {
+		LinkedAccounts []cloud.CloudLinkedAccount `json:"linkedAccounts"`
+	} `json:"cloudUpdateAccount"`
+}
+
+const cloudUpdateAccountMutation = `mutation(
+	$accountId: Int!,
+	$accounts: CloudUpdateCloudAccountsInput!,
+) { cloudUpdateAccount(
+	accountId: $accountId,
+	accounts: $accounts,
+) {
+	linkedAccounts {
+		id
+		name
+	}
+} }`
+
+func expandAzureCloudUpdateAccountInput(d *schema.ResourceData, linkedAccountID int) cloudUpdateCloudAccountsInput {
+	azureAccount := cloudAzureUpdateAccountInput{
+		LinkedAccountId: linkedAccountID,
+		Name:            d.Get("name").(string),
+	}
+
+	if d.HasChange("application_id") {
+		azureAccount.ApplicationID = d.Get("application_id").(string)
+	}
+
+	// The secret is never returned by the API, so it is only sent when rotated.
+	if d.HasChange("client_secret") {
+		azureAccount.ClientSecret = cloud.SecureValue(d.Get("client_secret").(string))
+	}
+
+	if d.HasChange("subscription_id") {
+		azureAccount.SubscriptionId = d.Get("subscription_id").(string)
+	}
+
+	if d.HasChange("tenant_id") {
+		azureAccount.TenantId = d.Get("tenant_id").(string)
+	}
+
+	return cloudUpdateCloudAccountsInput{
+		Azure: []cloudAzureUpdateAccountInput{azureAccount},
+	}
 }
 
 func resourceNewRelicCloudAzureLinkAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,127 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAzureCloudUpdateAccountInput_ClientSecretRotation(t *testing.T) {
+	r := resourceNewRelicCloudAzureLinkAccount()
+
+	// Rotating the secret must update the linked account in place.
+	require.False(t, r.Schema["client_secret"].ForceNew)
+	require.True(t, r.Schema["client_secret"].Sensitive)
+
+	state := &terraform.InstanceState{
+		ID: "123",
+		Attributes: map[string]string{
+			"account_id":      "1",
+			"application_id":  "application",
+			"client_secret":   "old-secret",
+			"name":            "azure-account",
+			"subscription_id": "subscription",
+			"tenant_id":       "tenant",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"client_secret": {Old: "old-secret", New: "new-secret"},
+		},
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.NoError(t, err)
+	require.True(t, d.HasChange("client_secret"))
+	require.False(t, d.HasChange("application_id"))
+
+	input := expandAzureCloudUpdateAccountInput(d, 123)
+
+	require.Equal(t, cloudUpdateCloudAccountsInput{
+		Azure: []cloudAzureUpdateAccountInput{
+			{
+				ClientSecret:    cloud.SecureValue("new-secret"),
+				LinkedAccountId: 123,
+				Name:            "azure-account",
+			},
+		},
+	}, input)
+}
+
+func TestReadAzureLinkedAccount_PreservesClientSecret(t *testing.T) {
+	r := resourceNewRelicCloudAzureLinkAccount()
+	d := r.Data(&terraform.InstanceState{
+		ID: "123",
+		Attributes: map[string]string{
+			"client_secret": "secret",
+			"tenant_id":     "tenant",
+		},
+	})
+
+	readAzureLinkedAccount(d, &cloud.CloudLinkedAccount{
+		ID:          123,
+		AuthLabel:   "application",
+		ExternalId:  "subscription",
+		Name:        "renamed",
+		NrAccountId: 1,
+	})
+
+	require.Equal(t, "secret", d.Get("client_secret"))
+	require.Equal(t, "tenant", d.Get("tenant_id"))
+	require.Equal(t, "application", d.Get("application_id"))
+	require.Equal(t, "subscription", d.Get("subscription_id"))
+	require.Equal(t, "renamed", d.Get("name"))
+	require.Equal(t, 1, d.Get("account_id"))
+}
+
+func TestValidateAzureLinkAccountCredentials(t *testing.T) {
+	valid := func() map[string]string {
+		return map[string]string{
+			"application_id":  "7b6d0b1e-3a1f-4c52-9a7e-1d2f3c4b5a69",
+			"tenant_id":       "0c8a4e2f-5b6d-4a3c-8e1f-2d3c4b5a6978",
+			"subscription_id": "5f4e3d2c-1b0a-4f9e-8d7c-6b5a49382716",
+			"client_secret":   "secret",
+		}
+	}
+
+	require.NoError(t, validateAzureLinkAccountCredentials(valid()))
+
+	partial := valid()
+	partial["tenant_id"] = ""
+	partial["client_secret"] = " "
+	require.EqualError(t,
+		validateAzureLinkAccountCredentials(partial),
+		"application_id, tenant_id, subscription_id, client_secret must be configured together, missing: tenant_id, client_secret",
+	)
+
+	invalid := valid()
+	invalid["subscription_id"] = "subscription"
+	require.EqualError(t,
+		validateAzureLinkAccountCredentials(invalid),
+		`expected "subscription_id" to be a valid UUID, got subscription`,
+	)
+}
+
+func TestCloudAzureLinkAccountCustomizeDiff_PartialConfiguration(t *testing.T) {
+	r := resourceNewRelicCloudAzureLinkAccount()
+
+	config := map[string]interface{}{
+		"name":            "azure-account",
+		"application_id":  "7b6d0b1e-3a1f-4c52-9a7e-1d2f3c4b5a69",
+		"tenant_id":       "0c8a4e2f-5b6d-4a3c-8e1f-2d3c4b5a6978",
+		"subscription_id": "5f4e3d2c-1b0a-4f9e-8d7c-6b5a49382716",
+		"client_secret":   "secret",
+	}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+
+	config["application_id"] = ""
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.EqualError(t, err, "application_id, tenant_id, subscription_id, client_secret must be configured together, missing: application_id")
+}
@@ -17,7 +17,7 @@ func resourceNewrelicCloudGcpIntegrations() *schema.Resource {
 		UpdateContext: resourceNewrelicCloudGcpIntegrationsUpdate,
 		DeleteContext: resourceNewrelicCloudGcpIntegrationsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithAccountID(),
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -31,6 +31,7 @@ func resourceNewrelicCloudGcpIntegrations() *schema.Resource {
 				Description: "Id of the linked gcp account in New Relic",
 				Required:    true,
 			},
+			"integration_status": cloudIntegrationStatusSchema(),
 			"alloy_db": {
 				Type:        schema.TypeList,
 				Description: "GCP alloy DB integration",
@@ -463,27 +464,23 @@ func resourceNewrelicCloudGcpIntegrationsCreate(ctx context.Context, d *schema.R
 	cloudGcpIntegrationinputs, _ := expandCloudGcpIntegrationsinputs(d)
 	gcpIntegrationspayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, cloudGcpIntegrationinputs)
 	if err != nil {
-		diag.FromErr(err)
-	}
-	var diags diag.Diagnostics
-	if len(gcpIntegrationspayload.Errors) > 0 {
-		for _, err := range gcpIntegrationspayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
+		return diag.FromErr(err)
 	}
+
+	// Track the services that were configured, even when others failed
 	if len(gcpIntegrationspayload.Integrations) > 0 {
 		d.SetId(strconv.Itoa(d.Get("linked_account_id").(int)))
 	}
-	return nil
+
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, gcpIntegrationspayload.Errors)
+	if diags.HasError() && d.Id() != "" {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewrelicCloudGcpIntegrations())...)
+	}
+
+	return diags
 }
 
 // expand function to extract inputs for cloud integrations from the schema
-// TODO: Reduce the cyclomatic complexity of this func
-// nolint:gocyclo
 func expandCloudGcpIntegrationsinputs(d *schema.ResourceData) (cloud.CloudIntegrationsInput, cloud.CloudDisableIntegrationsInput) {
 	gcpCloudIntegrations := cloud.CloudGcpIntegrationsInput{}
 	gcpDisableIntegrations := cloud.CloudGcpDisableIntegrationsInput{}
@@ -491,160 +488,218 @@ func expandCloudGcpIntegrationsinputs(d *schema.ResourceData) (cloud.CloudIntegr
 	if lid, ok := d.GetOk("linked_account_id"); ok {
 		linkedAccountID = lid.(int)
 	}
-	if v, ok := d.GetOk("alloy_db"); ok {
-		gcpCloudIntegrations.GcpAlloydb = expandCloudGcpAlloyDBIntegrationsInputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("alloy_db"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpAlloydb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("app_engine"); ok {
-		gcpCloudIntegrations.GcpAppengine = expandCloudGcpAppEngineIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("app_engine"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpAppengine = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("big_query"); ok {
-		gcpCloudIntegrations.GcpBigquery = expandCloudGcpBigQueryIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("big_query"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpBigquery = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("big_table"); ok {
-		gcpCloudIntegrations.GcpBigtable = expandCloudGcpBigTableIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("big_table"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpBigtable = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("composer"); ok {
-		gcpCloudIntegrations.GcpComposer = expandCloudGcpComposerIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("composer"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpComposer = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("data_flow"); ok {
-		gcpCloudIntegrations.GcpDataflow = expandCloudGcpDataFlowIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("data_flow"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpDataflow = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("data_proc"); ok {
-		gcpCloudIntegrations.GcpDataproc = expandCloudGcpDataProcIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("data_proc"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpDataproc = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("data_store"); ok {
-		gcpCloudIntegrations.GcpDatastore = expandCloudGcpDataStoreIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("data_store"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpDatastore = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("fire_base_database"); ok {
-		gcpCloudIntegrations.GcpFirebasedatabase = expandCloudGcpFireBaseDatabaseIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("fire_base_database"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpFirebasedatabase = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("fire_base_hosting"); ok {
-		gcpCloudIntegrations.GcpFirebasehosting = expandCloudGcpFireBaseHostingIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("fire_base_hosting"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpFirebasehosting = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("fire_base_storage"); ok {
-		gcpCloudIntegrations.GcpFirebasestorage = expandCloudGcpFireBaseStorageIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("fire_base_storage"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpFirebasestorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("fire_store"); ok {
-		gcpCloudIntegrations.GcpFirestore = expandCloudGcpFireStoreIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("fire_store"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpFirestore = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("functions"); ok {
-		gcpCloudIntegrations.GcpFunctions = expandCloudGcpFunctionsIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("functions"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpFunctions = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("interconnect"); ok {
-		gcpCloudIntegrations.GcpInterconnect = expandCloudGcpInterconnectIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("interconnect"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpInterconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("kubernetes"); ok {
-		gcpCloudIntegrations.GcpKubernetes = expandCloudGcpKubernetesIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("kubernetes"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpKubernetes = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("load_balancing"); ok {
-		gcpCloudIntegrations.GcpLoadbalancing = expandCloudGcpLoadBalancingIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("load_balancing"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpLoadbalancing = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("mem_cache"); ok {
-		gcpCloudIntegrations.GcpMemcache = expandCloudGcpMemCacheIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("mem_cache"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpMemcache = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("pub_sub"); ok {
-		gcpCloudIntegrations.GcpPubsub = expandCloudGcpPubSubIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("pub_sub"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpPubsub = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("redis"); ok {
-		gcpCloudIntegrations.GcpRedis = expandCloudGcpRedisIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("redis"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpRedis = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("router"); ok {
-		gcpCloudIntegrations.GcpRouter = expandCloudGcpRouterIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("router"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpRouter = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("run"); ok {
-		gcpCloudIntegrations.GcpRun = expandCloudGcpRunIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("run"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpRun = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("spanner"); ok {
-		gcpCloudIntegrations.GcpSpanner = expandCloudGcpSpannerIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("spanner"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpSpanner = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("sql"); ok {
-		gcpCloudIntegrations.GcpSql = expandCloudGcpSQLIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("sql"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpSql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("storage"); ok {
-		gcpCloudIntegrations.GcpStorage = expandCloudGcpStorageIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("storage"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpStorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-
-	if v, ok := d.GetOk("virtual_machines"); ok {
-		gcpCloudIntegrations.GcpVms = expandCloudGcpVirtualMachinesIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("virtual_machines"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpVms = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	integrationSpecs := map[string]cloudIntegrationSpec{
+		"alloy_db": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpAlloydb = expandCloudGcpAlloyDBIntegrationsInputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpAlloydb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"app_engine": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpAppengine = expandCloudGcpAppEngineIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpAppengine = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"big_query": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpBigquery = expandCloudGcpBigQueryIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpBigquery = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"big_table": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpBigtable = expandCloudGcpBigTableIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpBigtable = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"composer": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpComposer = expandCloudGcpComposerIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpComposer = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"data_flow": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpDataflow = expandCloudGcpDataFlowIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpDataflow = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"data_proc": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpDataproc = expandCloudGcpDataProcIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpDataproc = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"data_store": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpDatastore = expandCloudGcpDataStoreIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpDatastore = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"fire_base_database": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpFirebasedatabase = expandCloudGcpFireBaseDatabaseIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpFirebasedatabase = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"fire_base_hosting": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpFirebasehosting = expandCloudGcpFireBaseHostingIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpFirebasehosting = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"fire_base_storage": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpFirebasestorage = expandCloudGcpFireBaseStorageIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpFirebasestorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"fire_store": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpFirestore = expandCloudGcpFireStoreIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpFirestore = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"functions": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpFunctions = expandCloudGcpFunctionsIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpFunctions = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"interconnect": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpInterconnect = expandCloudGcpInterconnectIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpInterconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"kubernetes": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpKubernetes = expandCloudGcpKubernetesIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpKubernetes = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"load_balancing": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpLoadbalancing = expandCloudGcpLoadBalancingIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpLoadbalancing = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"mem_cache": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpMemcache = expandCloudGcpMemCacheIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpMemcache = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"pub_sub": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpPubsub = expandCloudGcpPubSubIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpPubsub = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"redis": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpRedis = expandCloudGcpRedisIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpRedis = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"router": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpRouter = expandCloudGcpRouterIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpRouter = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"run": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpRun = expandCloudGcpRunIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpRun = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"spanner": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpSpanner = expandCloudGcpSpannerIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpSpanner = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sql": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpSql = expandCloudGcpSQLIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpSql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"storage": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpStorage = expandCloudGcpStorageIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpStorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"virtual_machines": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpVms = expandCloudGcpVirtualMachinesIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpVms = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"vpc_access": {
+			enableFunc: func(a []interface{}, id int) {
+				gcpCloudIntegrations.GcpVpcaccess = expandCloudGcpVpcAccessIntegrationsinputs(a, id)
+			},
+			disableFunc: func(id int) {
+				gcpDisableIntegrations.GcpVpcaccess = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
 	}
 
-	if v, ok := d.GetOk("vpc_access"); ok {
-		gcpCloudIntegrations.GcpVpcaccess = expandCloudGcpVpcAccessIntegrationsinputs(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("vpc_access"); len(n.([]interface{})) < len(o.([]interface{})) {
-		gcpDisableIntegrations.GcpVpcaccess = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
+	reconcileCloudIntegrations(d, linkedAccountID, integrationSpecs)
 
 	configureInput := cloud.CloudIntegrationsInput{
 		Gcp: gcpCloudIntegrations,
@@ -1202,7 +1257,7 @@ func resourceNewrelicCloudGcpIntegrationsRead(ctx context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 	flattenCloudGcpLinkedAccount(d, linkedAccount)
 	return nil
@@ -1214,6 +1269,7 @@ func resourceNewrelicCloudGcpIntegrationsRead(ctx context.Context, d *schema.Res
 func flattenCloudGcpLinkedAccount(d *schema.ResourceData, linkedAccount *cloud.CloudLinkedAccount) {
 	_ = d.Set("account_id", linkedAccount.NrAccountId)
 	_ = d.Set("linked_account_id", linkedAccount.ID)
+	_ = d.Set("integration_status", flattenCloudIntegrationStatus(linkedAccount.Integrations))
 	for _, i := range linkedAccount.Integrations {
 		switch t := i.(type) {
 		case *cloud.CloudGcpAlloydbIntegration:
@@ -1519,32 +1575,20 @@ func resourceNewrelicCloudGcpIntegrationsUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
+	// A service failing doesn't stop the other services from being applied
+	diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, cloudDisableIntegrationsPayload.Errors)
 
-	if len(cloudDisableIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudDisableIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
-	}
 	cloudGcpIntegrationsPayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, configureInput)
-
 	if err != nil {
-		return diag.FromErr(err)
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		diags = append(diags, buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionConfigure, cloudGcpIntegrationsPayload.Errors)...)
 	}
 
-	if len(cloudGcpIntegrationsPayload.Errors) > 0 {
-		for _, err := range cloudGcpIntegrationsPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
-		return diags
+	if diags.HasError() {
+		return append(diags, readAppliedCloudIntegrations(ctx, d, meta, resourceNewrelicCloudGcpIntegrations())...)
 	}
+
 	return nil
 }
 
@@ -1558,15 +1602,7 @@ func resourceNewrelicCloudGcpIntegrationsDelete(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if len(gcpDisablePayload.Errors) > 0 {
-		for _, err := range gcpDisablePayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
-		}
+	if diags := buildCloudIntegrationMutationDiagnostics(cloudIntegrationActionDisable, gcpDisablePayload.Errors); diags.HasError() {
 		return diags
 	}
 
@@ -67,6 +67,68 @@ func TestAccNewRelicCloudGcpIntegrations_Basic(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicCloudGcpIntegrations_Import(t *testing.T) {
+	resourceName := "newrelic_cloud_gcp_integrations.foo1"
+	testGCPIntegrationName := fmt.Sprintf("tf_cloud_integrations_test_gcp_%s", acctest.RandString(5))
+
+	if subAccountIDExists := os.Getenv("NEW_RELIC_SUBACCOUNT_ID"); subAccountIDExists == "" {
+		t.Skipf("Skipping this test, as NEW_RELIC_SUBACCOUNT_ID must be set for this test to run.")
+	}
+
+	testGCPProjectID := os.Getenv("INTEGRATION_TESTING_GCP_PROJECT_ID")
+	if testGCPProjectID == "" {
+		t.Skipf("INTEGRATION_TESTING_GCP_PROJECT_ID must be set for acceptance test")
+	}
+
+	GCPIntegrationTestConfig := map[string]string{
+		"name":       testGCPIntegrationName,
+		"account_id": strconv.Itoa(testSubAccountID),
+		"project_id": testGCPProjectID,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCloudLinkedAccountsCleanup(t, "gcp") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicCloudGcpIntegrationsDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicCloudGcpIntegrationsConfig(GCPIntegrationTestConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicCloudGcpIntegrationsExists(resourceName),
+				),
+				PreConfig: func() {
+					time.Sleep(10 * time.Second)
+				},
+			},
+			// Test: Import by <account_id>:<linked_account_id>, replacing the state
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				ImportStateIdFunc:  testAccNewRelicCloudGcpIntegrationsImportStateIDFunc(resourceName),
+			},
+			// Test: The imported state plans clean against the configuration
+			{
+				Config:   testAccNewRelicCloudGcpIntegrationsConfig(GCPIntegrationTestConfig),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccNewRelicCloudGcpIntegrationsImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["account_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccNewRelicCloudGcpIntegrationsExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
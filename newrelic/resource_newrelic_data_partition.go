@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,7 +23,7 @@ func resourceNewRelicDataPartition() *schema.Resource {
 		UpdateContext: resourceNewRelicDataPartitionUpdate,
 		DeleteContext: resourceNewRelicDataPartitionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithAccountID(),
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -42,9 +43,11 @@ func resourceNewRelicDataPartition() *schema.Resource {
 				Required:    true,
 			},
 			"nrql": {
-				Type:        schema.TypeString,
-				Description: "The NRQL to match events for this data partition rule. Logs matching this criteria will be routed to the specified data partition.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The NRQL to match events for this data partition rule. Logs matching this criteria will be routed to the specified data partition.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"nrql", "attribute_name"},
 			},
 			"retention_policy": {
 				Type:         schema.TypeString,
@@ -59,6 +62,50 @@ func resourceNewRelicDataPartition() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"attribute_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The attribute name against which the matching criteria of the data partition rule is evaluated.",
+				ExactlyOneOf: []string{"nrql", "attribute_name"},
+				RequiredWith: []string{"matching_method"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new) // Attribute names are matched regardless of their case
+				},
+			},
+			"matching_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The matching method of the data partition rule matching criteria. Valid values are: 'EQUALS' or 'LIKE' (case insensitive).",
+				RequiredWith: []string{"attribute_name"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					string(logconfigurations.LogConfigurationsDataPartitionRuleMatchingOperatorTypes.EQUALS),
+					string(logconfigurations.LogConfigurationsDataPartitionRuleMatchingOperatorTypes.LIKE),
+				}, true)),
+				StateFunc: func(v interface{}) string {
+					// Always store uppercase, as returned by the API, to prevent state drift
+					return strings.ToUpper(v.(string))
+				},
+			},
+			"matching_expression": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The matching expression of the data partition rule matching criteria.",
+				RequiredWith:  []string{"attribute_name", "matching_method"},
+				ConflictsWith: []string{"matching_expressions"},
+			},
+			"matching_expressions": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "The matching expressions of the data partition rule, any of which the attribute may match. They are sent as NRQL conditions joined with OR.",
+				RequiredWith:  []string{"attribute_name", "matching_method"},
+				ConflictsWith: []string{"matching_expression"},
+			},
 			"deleted": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -67,6 +114,8 @@ func resourceNewRelicDataPartition() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 	}
 }
@@ -78,6 +127,36 @@ func listValidDataPartitionRuleRetentionPolicyType() []string {
 	}
 }
 
+// dataPartitionRuleLimiter bounds how many data partition rules are created or deleted at once.
+// Terraform applies independent resources in parallel, and the logConfigurations API has no
+// bulk mutation, so a plan with many rules would otherwise send all of them at the same time.
+// A nil limiter doesn't limit anything.
+type dataPartitionRuleLimiter chan struct{}
+
+func newDataPartitionRuleLimiter(concurrency int) dataPartitionRuleLimiter {
+	return make(dataPartitionRuleLimiter, concurrency)
+}
+
+// acquire waits for a free slot, giving up when ctx is done.
+func (l dataPartitionRuleLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l dataPartitionRuleLimiter) release() {
+	if l != nil {
+		<-l
+	}
+}
+
 // Create the data partition rule
 func resourceNewRelicDataPartitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
@@ -88,7 +167,11 @@ func resourceNewRelicDataPartitionCreate(ctx context.Context, d *schema.Resource
 	createInput := logconfigurations.LogConfigurationsCreateDataPartitionRuleInput{
 		Description: d.Get("description").(string),
 		Enabled:     d.Get("enabled").(bool),
-		NRQL:        logconfigurations.NRQL(d.Get("nrql").(string)),
+	}
+
+	createInput.NRQL, createInput.MatchingCriteria = expandDataPartitionRuleDefinition(d)
+	if createInput.NRQL == "" && createInput.MatchingCriteria == nil {
+		return diag.Errorf("attribute_name requires one of matching_expression or matching_expressions")
 	}
 
 	//The name of a log data partition. Has to start with 'Log_' prefix and can only contain alphanumeric characters and underscores.
@@ -101,7 +184,12 @@ func resourceNewRelicDataPartitionCreate(ctx context.Context, d *schema.Resource
 	}
 	log.Printf("[INFO] Creating New Relic Data Partition Rule  %s", createInput.TargetDataPartition)
 
+	// Only the mutation holds a slot, so that rules waiting to become visible don't hold up the others
+	if err := providerConfig.dataPartitionRuleMutations.acquire(ctx); err != nil {
+		return diag.FromErr(err)
+	}
 	created, err := client.Logconfigurations.LogConfigurationsCreateDataPartitionRuleWithContext(ctx, accountID, createInput)
+	providerConfig.dataPartitionRuleMutations.release()
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -146,7 +234,8 @@ func resourceNewRelicDataPartitionCreate(ctx context.Context, d *schema.Resource
 	if retryErr != nil {
 		return diag.FromErr(retryErr)
 	}
-	return nil
+
+	return resourceNewRelicDataPartitionRead(ctx, d, meta)
 }
 
 // Read the data partition rule
@@ -157,8 +246,17 @@ func resourceNewRelicDataPartitionRead(ctx context.Context, d *schema.ResourceDa
 
 	ruleID := d.Id()
 	rule, err := getDataPartitionByID(ctx, client, accountID, ruleID)
+	if err != nil {
+		// Other errors, e.g. an elapsed timeout, must not remove the rule from state
+		if errors.Is(err, errDataPartitionRuleNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
 
-	if err != nil || rule == nil || rule.Deleted {
+	if rule.Deleted {
 		d.SetId("")
 		return nil
 	}
@@ -175,6 +273,15 @@ func resourceNewRelicDataPartitionRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("target_data_partition", rule.TargetDataPartition)
 	_ = d.Set("nrql", rule.NRQL)
 	_ = d.Set("retention_policy", rule.RetentionPolicy)
+
+	// Rules matching any of several expressions are stored as NRQL only. Their expressions are
+	// kept for as long as the rule still has the NRQL built from them.
+	if rule.MatchingCriteria.AttributeName != "" || !dataPartitionRuleHasExpressionsNrql(d, rule.NRQL) {
+		_ = d.Set("attribute_name", rule.MatchingCriteria.AttributeName)
+		_ = d.Set("matching_method", string(rule.MatchingCriteria.MatchingOperator))
+		_ = d.Set("matching_expression", rule.MatchingCriteria.MatchingExpression)
+		_ = d.Set("matching_expressions", nil)
+	}
 	_ = d.Set("deleted", rule.Deleted)
 
 	return nil
@@ -209,7 +316,7 @@ func resourceNewRelicDataPartitionUpdate(ctx context.Context, d *schema.Resource
 		return apiDiags
 	}
 
-	return nil
+	return resourceNewRelicDataPartitionRead(ctx, d, meta)
 }
 
 func expandDataPartitionUpdateInput(d *schema.ResourceData) logconfigurations.LogConfigurationsUpdateDataPartitionRuleInput {
@@ -220,15 +327,49 @@ func expandDataPartitionUpdateInput(d *schema.ResourceData) logconfigurations.Lo
 		updateInp.Enabled = e.(bool)
 	}
 
-	if e, ok := d.GetOk("description"); ok {
-		updateInp.Description = e.(string)
+	// Always send the configured description so that changes made outside of Terraform are
+	// reverted on apply. The client omits an empty description though, so it can't be cleared.
+	updateInp.Description = d.Get("description").(string)
+
+	updateInp.NRQL, updateInp.MatchingCriteria = expandDataPartitionRuleDefinition(d)
+
+	return updateInp
+}
+
+// Returns either the NRQL or the matching criteria defining the rule.
+func expandDataPartitionRuleDefinition(d *schema.ResourceData) (logconfigurations.NRQL, *logconfigurations.LogConfigurationsDataPartitionRuleMatchingCriteriaInput) {
+	expressions := expandDataPartitionRuleMatchingExpressions(d.Get("matching_expressions").([]interface{}))
+
+	// Matching criteria have a single expression, so a rule matching any of several is sent as NRQL
+	if len(expressions) > 0 && (d.HasChange("matching_expressions") ||
+		d.Get("matching_expression").(string) == "" && d.HasChanges("attribute_name", "matching_method")) {
+		return buildDataPartitionRuleNrql(d.Get("attribute_name").(string), d.Get("matching_method").(string), expressions), nil
 	}
 
-	if e, ok := d.GetOk("nrql"); ok {
-		updateInp.NRQL = logconfigurations.NRQL(e.(string))
+	if dataPartitionRuleUsesMatchingCriteria(d) {
+		return "", expandDataPartitionRuleMatchingCriteria(d)
 	}
 
-	return updateInp
+	return logconfigurations.NRQL(d.Get("nrql").(string)), nil
+}
+
+// A rule is defined either by its NRQL or by matching criteria, which New Relic turns into NRQL,
+// and Read sets both of them. The matching criteria are sent for rules defined by them, unless
+// the plan only changes the NRQL, which then replaces them.
+func dataPartitionRuleUsesMatchingCriteria(d *schema.ResourceData) bool {
+	if d.Get("matching_expression").(string) == "" {
+		return false
+	}
+
+	return !d.HasChange("nrql") || d.HasChanges("attribute_name", "matching_method", "matching_expression")
+}
+
+func expandDataPartitionRuleMatchingCriteria(d *schema.ResourceData) *logconfigurations.LogConfigurationsDataPartitionRuleMatchingCriteriaInput {
+	return &logconfigurations.LogConfigurationsDataPartitionRuleMatchingCriteriaInput{
+		AttributeName:      d.Get("attribute_name").(string),
+		MatchingMethod:     logconfigurations.LogConfigurationsDataPartitionRuleMatchingOperator(strings.ToUpper(d.Get("matching_method").(string))),
+		MatchingExpression: d.Get("matching_expression").(string),
+	}
 }
 
 // Delete the data partition rule
@@ -241,6 +382,11 @@ func resourceNewRelicDataPartitionDelete(ctx context.Context, d *schema.Resource
 	accountID := selectAccountID(meta.(*ProviderConfig), d)
 	expressionID := d.Id()
 
+	if err := providerConfig.dataPartitionRuleMutations.acquire(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+	defer providerConfig.dataPartitionRuleMutations.release()
+
 	_, err := client.Logconfigurations.LogConfigurationsDeleteDataPartitionRuleWithContext(ctx, accountID, expressionID)
 
 	if err != nil {
@@ -250,6 +396,8 @@ func resourceNewRelicDataPartitionDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+var errDataPartitionRuleNotFound = errors.New("data partition rule not found")
+
 func getDataPartitionByID(ctx context.Context, client *newrelic.NewRelic, accountID int, ruleID string) (*logconfigurations.LogConfigurationsDataPartitionRule, error) {
 	rules, err := client.Logconfigurations.GetDataPartitionRulesWithContext(ctx, accountID)
 	if err != nil {
@@ -261,6 +409,45 @@ func getDataPartitionByID(ctx context.Context, client *newrelic.NewRelic, accoun
 			return &v, nil
 		}
 	}
-	return nil, errors.New("data partition rule not found")
+	return nil, errDataPartitionRuleNotFound
+
+}
+
+func expandDataPartitionRuleMatchingExpressions(cfg []interface{}) []string {
+	expressions := make([]string, 0, len(cfg))
+	for _, e := range cfg {
+		if expression, ok := e.(string); ok && expression != "" {
+			expressions = append(expressions, expression)
+		}
+	}
+
+	return expressions
+}
+
+var dataPartitionRuleNrqlEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// Builds the NRQL of a rule matching any of the expressions, e.g. `hostname` = 'web-1' OR `hostname` = 'web-2'.
+// With LIKE, the expressions are used as patterns as they are.
+func buildDataPartitionRuleNrql(attributeName string, method string, expressions []string) logconfigurations.NRQL {
+	operator := "="
+	if strings.EqualFold(method, string(logconfigurations.LogConfigurationsDataPartitionRuleMatchingOperatorTypes.LIKE)) {
+		operator = "LIKE"
+	}
+
+	conditions := make([]string, len(expressions))
+	for i, expression := range expressions {
+		conditions[i] = fmt.Sprintf("`%s` %s '%s'", attributeName, operator, dataPartitionRuleNrqlEscaper.Replace(expression))
+	}
+
+	return logconfigurations.NRQL(strings.Join(conditions, " OR "))
+}
+
+// Reports whether nrql is the NRQL built from the rule's matching expressions.
+func dataPartitionRuleHasExpressionsNrql(d *schema.ResourceData, nrql logconfigurations.NRQL) bool {
+	expressions := expandDataPartitionRuleMatchingExpressions(d.Get("matching_expressions").([]interface{}))
+	if len(expressions) == 0 {
+		return false
+	}
 
+	return nrql == buildDataPartitionRuleNrql(d.Get("attribute_name").(string), d.Get("matching_method").(string), expressions)
 }
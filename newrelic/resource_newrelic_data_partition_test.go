@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/logconfigurations"
 )
 
 // Checking the creation, update, import and deletion of data partition rule
@@ -95,6 +96,110 @@ func TestAccNewRelicDataPartitionRule_NRQLUpdate(t *testing.T) {
 	})
 }
 
+// Checking that a description changed outside of Terraform is detected and reverted
+func TestAccNewRelicDataPartitionRule_DescriptionDrift(t *testing.T) {
+	resourceName := "newrelic_data_partition_rule.foo"
+	rName := acctest.RandString(7)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccLogDataPartitionsCleanup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicDataPartitionRuleDestroy,
+		Steps: []resource.TestStep{
+			//create, then change the description out-of-band
+			{
+				Config: testAccNewRelicDataPartitionRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRuleExists(resourceName),
+					testAccNewRelicDataPartitionRuleUpdateDescription(resourceName, "changed outside of terraform"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			//plan must detect the drift
+			{
+				Config:             testAccNewRelicDataPartitionRuleConfig(rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			//apply must revert the drift
+			{
+				Config: testAccNewRelicDataPartitionRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", testAccExpectedApplicationName),
+				),
+			},
+		},
+	})
+}
+
+// Checking a rule defined by matching criteria instead of NRQL
+func TestAccNewRelicDataPartitionRule_MatchingCriteria(t *testing.T) {
+	resourceName := "newrelic_data_partition_rule.foo"
+	rName := acctest.RandString(7)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccLogDataPartitionsCleanup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicDataPartitionRuleDestroy,
+		Steps: []resource.TestStep{
+			//create
+			{
+				Config: testAccNewRelicDataPartitionRuleMatchingCriteria(rName, "web-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "matching_expression", "web-1"),
+				),
+			},
+			//update
+			{
+				Config: testAccNewRelicDataPartitionRuleMatchingCriteria(rName, "web-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "matching_expression", "web-2"),
+				),
+			},
+			//import
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+// Checking that a rule matching several expressions has no diff after apply
+func TestAccNewRelicDataPartitionRule_MatchingExpressions(t *testing.T) {
+	resourceName := "newrelic_data_partition_rule.foo"
+	rName := acctest.RandString(7)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccLogDataPartitionsCleanup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicDataPartitionRuleDestroy,
+		Steps: []resource.TestStep{
+			//create
+			{
+				Config: testAccNewRelicDataPartitionRuleMatchingExpressions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicDataPartitionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "matching_expressions.#", "3"),
+				),
+			},
+			//plan must be empty
+			{
+				Config:   testAccNewRelicDataPartitionRuleMatchingExpressions(rName),
+				PlanOnly: true,
+			},
+			//import
+			{
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attribute_name", "matching_method", "matching_expressions"},
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
 // Must fail if given the same name
 func TestAccNewRelicDataPartitionRule_DuplicateName(t *testing.T) {
 	rName := acctest.RandString(7)
@@ -169,6 +274,35 @@ func testAccCheckNewRelicDataPartitionRuleExists(n string) resource.TestCheckFun
 	}
 }
 
+func testAccNewRelicDataPartitionRuleUpdateDescription(n string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+		updated, err := client.Logconfigurations.LogConfigurationsUpdateDataPartitionRuleWithContext(
+			context.Background(),
+			testAccountID,
+			logconfigurations.LogConfigurationsUpdateDataPartitionRuleInput{
+				ID:          rs.Primary.ID,
+				Description: description,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(updated.Errors) > 0 {
+			return fmt.Errorf("error updating data partition rule: %s", updated.Errors[0].Message)
+		}
+
+		return nil
+	}
+}
+
 func testAccNewRelicDataPartitionRuleConfig(name string) string {
 	return fmt.Sprintf(`
 resource "newrelic_data_partition_rule" "foo"{
@@ -267,3 +401,33 @@ resource "newrelic_data_partition_rule" "foo"{
 }
 `, testAccountID, name, testAccExpectedApplicationName)
 }
+
+func testAccNewRelicDataPartitionRuleMatchingCriteria(name string, expression string) string {
+	return fmt.Sprintf(`
+resource "newrelic_data_partition_rule" "foo"{
+	account_id = %[1]d
+	description = "%[3]s"
+	enabled = true
+	attribute_name = "hostname"
+	matching_method = "EQUALS"
+	matching_expression = "%[4]s"
+    retention_policy = "SECONDARY"
+    target_data_partition = "Log_Test_%[2]s"
+}
+`, testAccountID, name, testAccExpectedApplicationName, expression)
+}
+
+func testAccNewRelicDataPartitionRuleMatchingExpressions(name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_data_partition_rule" "foo"{
+	account_id = %[1]d
+	description = "%[3]s"
+	enabled = true
+	attribute_name = "hostname"
+	matching_method = "EQUALS"
+	matching_expressions = ["web-1", "web-2", "web-3"]
+    retention_policy = "SECONDARY"
+    target_data_partition = "Log_Test_%[2]s"
+}
+`, testAccountID, name, testAccExpectedApplicationName)
+}
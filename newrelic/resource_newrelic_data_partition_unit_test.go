@@ -0,0 +1,704 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/logconfigurations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDataPartitionRuleData plans config against a rule in the given state, returning the
+// data seen by Create, when state is nil, or by Update.
+func testDataPartitionRuleData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	r := resourceNewRelicDataPartition()
+
+	var s *terraform.InstanceState
+	if state != nil {
+		s = &terraform.InstanceState{ID: "1", Attributes: state}
+	}
+
+	diff, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+
+	d, err := schema.InternalMap(r.Schema).Data(s, diff)
+	require.NoError(t, err)
+
+	return d
+}
+
+func TestDataPartitionRuleMatchingCriteriaInput(t *testing.T) {
+	nrqlRule := map[string]string{
+		"id":                    "1",
+		"account_id":            "1",
+		"enabled":               "true",
+		"nrql":                  "logtype = 'nginx'",
+		"retention_policy":      "STANDARD",
+		"target_data_partition": "Log_Nginx",
+		"attribute_name":        "",
+		"matching_method":       "",
+		"matching_expression":   "",
+	}
+	criteriaRule := map[string]string{
+		"id":                    "1",
+		"account_id":            "1",
+		"enabled":               "true",
+		"nrql":                  "hostname = 'web-1'",
+		"retention_policy":      "STANDARD",
+		"target_data_partition": "Log_Web",
+		"attribute_name":        "hostname",
+		"matching_method":       "EQUALS",
+		"matching_expression":   "web-1",
+	}
+	criteriaConfig := func(expression string) map[string]interface{} {
+		return map[string]interface{}{
+			"account_id":            1,
+			"description":           "updated",
+			"enabled":               true,
+			"retention_policy":      "STANDARD",
+			"target_data_partition": "Log_Web",
+			"attribute_name":        "hostname",
+			"matching_method":       "EQUALS",
+			"matching_expression":   expression,
+		}
+	}
+	nrqlConfig := func(nrql string) map[string]interface{} {
+		return map[string]interface{}{
+			"account_id":            1,
+			"description":           "updated",
+			"enabled":               true,
+			"nrql":                  nrql,
+			"retention_policy":      "STANDARD",
+			"target_data_partition": "Log_Web",
+		}
+	}
+
+	cases := map[string]struct {
+		state            map[string]string
+		config           map[string]interface{}
+		expectedCriteria string
+		expectedNRQL     string
+	}{
+		"create with nrql": {
+			config:       nrqlConfig("logtype = 'nginx'"),
+			expectedNRQL: "logtype = 'nginx'",
+		},
+		"create with matching criteria": {
+			config:           criteriaConfig("web-1"),
+			expectedCriteria: "web-1",
+		},
+		"update a rule defined by nrql": {
+			state:        nrqlRule,
+			config:       nrqlConfig("logtype = 'nginx'"),
+			expectedNRQL: "logtype = 'nginx'",
+		},
+		"update the matching expression": {
+			state:            criteriaRule,
+			config:           criteriaConfig("web-2"),
+			expectedCriteria: "web-2",
+		},
+		"update only the description of a rule defined by matching criteria": {
+			state:            criteriaRule,
+			config:           criteriaConfig("web-1"),
+			expectedCriteria: "web-1",
+		},
+		"replace matching criteria with nrql": {
+			state:        criteriaRule,
+			config:       nrqlConfig("hostname LIKE '%web%'"),
+			expectedNRQL: "hostname LIKE '%web%'",
+		},
+		"replace nrql with matching criteria": {
+			state:            nrqlRule,
+			config:           criteriaConfig("web-1"),
+			expectedCriteria: "web-1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := testDataPartitionRuleData(t, tc.state, tc.config)
+
+			if tc.expectedCriteria == "" {
+				require.False(t, dataPartitionRuleUsesMatchingCriteria(d))
+				require.Equal(t, tc.expectedNRQL, d.Get("nrql"))
+				return
+			}
+
+			require.True(t, dataPartitionRuleUsesMatchingCriteria(d))
+			require.Equal(t, &logconfigurations.LogConfigurationsDataPartitionRuleMatchingCriteriaInput{
+				AttributeName:      "hostname",
+				MatchingMethod:     logconfigurations.LogConfigurationsDataPartitionRuleMatchingOperatorTypes.EQUALS,
+				MatchingExpression: tc.expectedCriteria,
+			}, expandDataPartitionRuleMatchingCriteria(d))
+
+			if tc.state != nil {
+				input := expandDataPartitionUpdateInput(d)
+				require.NotNil(t, input.MatchingCriteria)
+				require.Empty(t, input.NRQL)
+			}
+		})
+	}
+}
+
+func TestDataPartitionRuleNrqlOrMatchingCriteria(t *testing.T) {
+	r := resourceNewRelicDataPartition()
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	base := map[string]interface{}{
+		"enabled":               true,
+		"retention_policy":      "STANDARD",
+		"target_data_partition": "Log_Web",
+	}
+	with := func(attrs map[string]interface{}) map[string]interface{} {
+		config := map[string]interface{}{}
+		for k, v := range base {
+			config[k] = v
+		}
+		for k, v := range attrs {
+			config[k] = v
+		}
+		return config
+	}
+	criteria := map[string]interface{}{
+		"attribute_name":      "hostname",
+		"matching_method":     "EQUALS",
+		"matching_expression": "web-1",
+	}
+
+	require.False(t, r.Validate(terraform.NewResourceConfigRaw(with(map[string]interface{}{"nrql": "hostname = 'web-1'"}))).HasError())
+	require.False(t, r.Validate(terraform.NewResourceConfigRaw(with(criteria))).HasError())
+
+	// Neither or both
+	require.True(t, r.Validate(terraform.NewResourceConfigRaw(base)).HasError())
+	criteria["nrql"] = "hostname = 'web-1'"
+	require.True(t, r.Validate(terraform.NewResourceConfigRaw(with(criteria))).HasError())
+
+	// Incomplete matching criteria
+	require.True(t, r.Validate(terraform.NewResourceConfigRaw(with(map[string]interface{}{"attribute_name": "hostname"}))).HasError())
+}
+
+func TestDataPartitionRuleAttributeNameCaseSuppressed(t *testing.T) {
+	suppress := resourceNewRelicDataPartition().Schema["attribute_name"].DiffSuppressFunc
+	require.NotNil(t, suppress)
+
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"hostname", "hostname", true},
+		{"hostname", "Hostname", true},
+		{"Hostname", "HOSTNAME", true},
+		{"hostname", "logtype", false},
+		{"hostname", "hostnames", false},
+		{"", "hostname", false},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.suppress, suppress("attribute_name", tc.old, tc.new, nil), "%s -> %s", tc.old, tc.new)
+	}
+}
+
+func TestBuildDataPartitionRuleNrql(t *testing.T) {
+	cases := map[string]struct {
+		method      string
+		expressions []string
+		nrql        string
+	}{
+		"equals": {
+			method:      "EQUALS",
+			expressions: []string{"web-1", "web-2", "web-3"},
+			nrql:        "`hostname` = 'web-1' OR `hostname` = 'web-2' OR `hostname` = 'web-3'",
+		},
+		"like": {
+			method:      "LIKE",
+			expressions: []string{"web-%", "%-api"},
+			nrql:        "`hostname` LIKE 'web-%' OR `hostname` LIKE '%-api'",
+		},
+		"single expression": {
+			method:      "EQUALS",
+			expressions: []string{"web-1"},
+			nrql:        "`hostname` = 'web-1'",
+		},
+		"escaped quotes": {
+			method:      "EQUALS",
+			expressions: []string{`it's`, `back\slash`},
+			nrql:        "`hostname` = 'it\\'s' OR `hostname` = 'back\\\\slash'",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.nrql, string(buildDataPartitionRuleNrql("hostname", tc.method, tc.expressions)))
+		})
+	}
+}
+
+func TestDataPartitionRuleMatchingExpressionsInput(t *testing.T) {
+	listRule := map[string]string{
+		"id":                     "1",
+		"account_id":             "1",
+		"enabled":                "true",
+		"nrql":                   "`hostname` = 'web-1' OR `hostname` = 'web-2'",
+		"retention_policy":       "STANDARD",
+		"target_data_partition":  "Log_Web",
+		"attribute_name":         "hostname",
+		"matching_method":        "EQUALS",
+		"matching_expression":    "",
+		"matching_expressions.#": "2",
+		"matching_expressions.0": "web-1",
+		"matching_expressions.1": "web-2",
+	}
+	criteriaRule := map[string]string{
+		"id":                    "1",
+		"account_id":            "1",
+		"enabled":               "true",
+		"nrql":                  "hostname = 'web-1'",
+		"retention_policy":      "STANDARD",
+		"target_data_partition": "Log_Web",
+		"attribute_name":        "hostname",
+		"matching_method":       "EQUALS",
+		"matching_expression":   "web-1",
+	}
+	listConfig := func(description string, expressions ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"account_id":            1,
+			"description":           description,
+			"enabled":               true,
+			"retention_policy":      "STANDARD",
+			"target_data_partition": "Log_Web",
+			"attribute_name":        "hostname",
+			"matching_method":       "EQUALS",
+			"matching_expressions":  expressions,
+		}
+	}
+
+	cases := map[string]struct {
+		state    map[string]string
+		config   map[string]interface{}
+		expected string
+	}{
+		"create": {
+			config:   listConfig("", "web-1", "web-2"),
+			expected: "`hostname` = 'web-1' OR `hostname` = 'web-2'",
+		},
+		"add an expression": {
+			state:    listRule,
+			config:   listConfig("", "web-1", "web-2", "web-3"),
+			expected: "`hostname` = 'web-1' OR `hostname` = 'web-2' OR `hostname` = 'web-3'",
+		},
+		"update only the description keeps the stored nrql": {
+			state:    listRule,
+			config:   listConfig("updated", "web-1", "web-2"),
+			expected: "`hostname` = 'web-1' OR `hostname` = 'web-2'",
+		},
+		"replace a single matching expression": {
+			state:    criteriaRule,
+			config:   listConfig("", "web-1", "web-2"),
+			expected: "`hostname` = 'web-1' OR `hostname` = 'web-2'",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			nrql, criteria := expandDataPartitionRuleDefinition(testDataPartitionRuleData(t, tc.state, tc.config))
+			require.Nil(t, criteria)
+			require.Equal(t, tc.expected, string(nrql))
+		})
+	}
+}
+
+// testDataPartitionRuleServer stores the NRQL of the rules it creates and lists them back
+// without matching criteria, the way New Relic stores rules defined by NRQL.
+func testDataPartitionRuleProviderConfig(t *testing.T) *ProviderConfig {
+	var mu sync.Mutex
+	rules := map[string]string{}
+
+	return testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Rule map[string]interface{} `json:"rule"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if strings.Contains(body.Query, "logConfigurationsCreateDataPartitionRule") {
+			id := fmt.Sprintf("rule-%d", len(rules)+1)
+			rules[id], _ = body.Variables.Rule["nrql"].(string)
+			_, _ = fmt.Fprintf(w, `{"data":{"logConfigurationsCreateDataPartitionRule":{"rule":{"id":%q}}}}`, id)
+			return
+		}
+
+		listed := []map[string]interface{}{}
+		for id, nrql := range rules {
+			listed = append(listed, map[string]interface{}{
+				"id":                  id,
+				"nrql":                nrql,
+				"enabled":             true,
+				"deleted":             false,
+				"retentionPolicy":     "STANDARD",
+				"targetDataPartition": "Log_Web",
+				"matchingCriteria":    map[string]interface{}{"attributeName": "", "matchingExpression": "", "matchingOperator": ""},
+			})
+		}
+		response, _ := json.Marshal(map[string]interface{}{
+			"data": map[string]interface{}{"actor": map[string]interface{}{"account": map[string]interface{}{
+				"logConfigurations": map[string]interface{}{"dataPartitionRules": listed},
+			}}},
+		})
+		_, _ = w.Write(response)
+	})
+}
+
+func TestDataPartitionRuleMatchingExpressions_NoDiffAfterApply(t *testing.T) {
+	t.Parallel()
+
+	meta := testDataPartitionRuleProviderConfig(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":            1,
+		"enabled":               true,
+		"retention_policy":      "STANDARD",
+		"target_data_partition": "Log_Web",
+		"attribute_name":        "hostname",
+		"matching_method":       "EQUALS",
+		"matching_expressions":  []interface{}{"web-1", "web-2", "web-3"},
+	})
+
+	r := resourceNewRelicDataPartition()
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+	require.NoError(t, err)
+
+	state, diags := r.Apply(context.Background(), nil, diff, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "`hostname` = 'web-1' OR `hostname` = 'web-2' OR `hostname` = 'web-3'", state.Attributes["nrql"])
+
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	require.NoError(t, err)
+	require.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// An imported rule only has its NRQL, which the first apply sends again
+	imported := r.Data(&terraform.InstanceState{ID: state.ID, Attributes: map[string]string{"account_id": "1"}})
+	diags = resourceNewRelicDataPartitionRead(context.Background(), imported, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Empty(t, imported.Get("matching_expressions"))
+
+	diff, err = r.Diff(context.Background(), imported.State(), config, meta)
+	require.NoError(t, err)
+	require.False(t, diff.Empty())
+
+	state, diags = r.Apply(context.Background(), imported.State(), diff, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "`hostname` = 'web-1' OR `hostname` = 'web-2' OR `hostname` = 'web-3'", state.Attributes["nrql"])
+
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	require.NoError(t, err)
+	require.True(t, diff == nil || diff.Empty(), "%v", diff)
+}
+
+func TestDataPartitionRuleMatchingMethodValidation(t *testing.T) {
+	r := resourceNewRelicDataPartition()
+
+	config := func(method string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"target_data_partition": "Log_test",
+			"retention_policy":      "STANDARD",
+			"enabled":               true,
+			"attribute_name":        "hostname",
+			"matching_method":       method,
+			"matching_expression":   "web-1",
+		})
+	}
+
+	for _, method := range []string{"EQUALS", "LIKE", "equals", "Like"} {
+		require.False(t, r.Validate(config(method)).HasError(), method)
+	}
+
+	for _, method := range []string{"", "CONTAINS", "EQUAL"} {
+		diags := r.Validate(config(method))
+		require.Len(t, diags, 1, method)
+		require.Equal(t, fmt.Sprintf("expected matching_method to be one of [EQUALS LIKE], got %s", method), diags[0].Summary)
+	}
+}
+
+func TestDataPartitionRuleMatchingMethodUppercased(t *testing.T) {
+	stateFunc := resourceNewRelicDataPartition().Schema["matching_method"].StateFunc
+	require.NotNil(t, stateFunc)
+
+	for _, method := range []string{"equals", "Equals", "EQUALS"} {
+		require.Equal(t, "EQUALS", stateFunc(method), method)
+	}
+	require.Equal(t, "LIKE", stateFunc("like"))
+
+	// The method is sent to New Relic uppercased, whatever its case in config
+	d := testDataPartitionRuleData(t, nil, map[string]interface{}{
+		"target_data_partition": "Log_test",
+		"retention_policy":      "STANDARD",
+		"enabled":               true,
+		"attribute_name":        "hostname",
+		"matching_method":       "like",
+		"matching_expression":   "web",
+	})
+
+	criteria := expandDataPartitionRuleMatchingCriteria(d)
+	require.Equal(t, logconfigurations.LogConfigurationsDataPartitionRuleMatchingOperatorTypes.LIKE, criteria.MatchingMethod)
+}
+
+func TestDataPartitionRuleRetentionPolicyValidation(t *testing.T) {
+	validate := resourceNewRelicDataPartition().Schema["retention_policy"].ValidateFunc
+	require.NotNil(t, validate)
+
+	for _, policy := range []string{"STANDARD", "SECONDARY"} {
+		_, errs := validate(policy, "retention_policy")
+		require.Empty(t, errs, policy)
+	}
+
+	for _, policy := range []string{"", "standard", "ARCHIVE"} {
+		_, errs := validate(policy, "retention_policy")
+		require.Len(t, errs, 1, policy)
+		require.EqualError(t, errs[0], fmt.Sprintf("expected retention_policy to be one of [SECONDARY STANDARD], got %s", policy))
+	}
+}
+
+func TestDataPartitionRuleImport(t *testing.T) {
+	meta := &ProviderConfig{AccountID: 1}
+
+	cases := map[string]struct {
+		importID          string
+		expectedID        string
+		expectedAccountID int
+		expectedErr       string
+	}{
+		"rule ID only": {
+			importID:          "4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f",
+			expectedID:        "4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f",
+			expectedAccountID: 1,
+		},
+		"account ID and rule ID": {
+			importID:          "2000:4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f",
+			expectedID:        "4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f",
+			expectedAccountID: 2000,
+		},
+		"missing rule ID": {
+			importID:    "2000:",
+			expectedErr: "import ID must be in the form of <account_id>:<id>, got: 2000:",
+		},
+		"invalid account ID": {
+			importID:    "sub-account:4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f",
+			expectedErr: "import ID must be in the form of <account_id>:<id>, got: sub-account:4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := resourceNewRelicDataPartition().TestResourceData()
+			d.SetId(tc.importID)
+
+			result, err := resourceImportStateWithAccountID()(context.Background(), d, meta)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			require.Equal(t, tc.expectedID, result[0].Id())
+			require.Equal(t, tc.expectedAccountID, result[0].Get("account_id"))
+		})
+	}
+}
+
+// testSlowDataPartitionProviderConfig only answers requests once the test is over.
+func testSlowDataPartitionProviderConfig(t *testing.T) *ProviderConfig {
+	release := make(chan struct{})
+
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	// Cleanups run last registered first, so the requests are released before the server closes.
+	t.Cleanup(func() { close(release) })
+
+	return meta
+}
+
+func TestDataPartitionRuleTimeouts(t *testing.T) {
+	timeouts := resourceNewRelicDataPartition().Timeouts
+	require.NotNil(t, timeouts)
+	require.Equal(t, 30*time.Second, *timeouts.Create)
+	require.Equal(t, 20*time.Minute, *timeouts.Update)
+	require.Equal(t, 20*time.Minute, *timeouts.Delete)
+}
+
+func TestDataPartitionRuleCreate_ConfiguredTimeout(t *testing.T) {
+	t.Parallel()
+
+	r := resourceNewRelicDataPartition()
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":            1,
+		"enabled":               true,
+		"nrql":                  "logtype = 'nginx'",
+		"retention_policy":      "STANDARD",
+		"target_data_partition": "Log_Nginx",
+		"timeouts": []interface{}{
+			map[string]interface{}{"create": "1s"},
+		},
+	}), nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, diags := r.Apply(context.Background(), nil, diff, testSlowDataPartitionProviderConfig(t))
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "context deadline exceeded")
+	require.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestDataPartitionRuleRead_TimeoutKeepsState(t *testing.T) {
+	t.Parallel()
+
+	d := resourceNewRelicDataPartition().TestResourceData()
+	d.SetId("4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	diags := resourceNewRelicDataPartitionRead(ctx, d, testSlowDataPartitionProviderConfig(t))
+
+	require.True(t, diags.HasError())
+	require.Equal(t, "4a6ab3ae-1234-4c5d-8e9f-0a1b2c3d4e5f", d.Id())
+}
+
+func TestDataPartitionRuleCreate_BoundedConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const rules = 12
+	const concurrency = 3
+
+	var (
+		mu                sync.Mutex
+		created           []string
+		inFlight, maxSeen int32
+	)
+
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Rule map[string]interface{} `json:"rule"`
+			} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		if !strings.Contains(body.Query, "logConfigurationsCreateDataPartitionRule") {
+			mu.Lock()
+			defer mu.Unlock()
+
+			existing := make([]string, len(created))
+			for i, id := range created {
+				existing[i] = fmt.Sprintf(`{"id":%q,"deleted":false}`, id)
+			}
+			_, _ = fmt.Fprintf(w, `{"data":{"actor":{"account":{"logConfigurations":{"dataPartitionRules":[%s]}}}}}`, strings.Join(existing, ","))
+			return
+		}
+
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxSeen)
+			if n <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+
+		partition, _ := body.Variables.Rule["targetDataPartition"].(string)
+		if partition == "Log_Rule_7" {
+			_, _ = w.Write([]byte(`{"data":{"logConfigurationsCreateDataPartitionRule":{"errors":[{"message":"Log_Rule_7 already exists","type":"DUPLICATE_DATA_PARTITION_RULE_NAME"}]}}}`))
+			return
+		}
+
+		id := "rule-" + partition
+		mu.Lock()
+		created = append(created, id)
+		mu.Unlock()
+
+		_, _ = fmt.Fprintf(w, `{"data":{"logConfigurationsCreateDataPartitionRule":{"rule":{"id":%q}}}}`, id)
+	})
+	meta.dataPartitionRuleMutations = newDataPartitionRuleLimiter(concurrency)
+
+	r := resourceNewRelicDataPartition()
+	states := make([]*terraform.InstanceState, rules)
+	diags := make([]diag.Diagnostics, rules)
+
+	var wg sync.WaitGroup
+	for i := 0; i < rules; i++ {
+		diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"enabled":               true,
+			"nrql":                  fmt.Sprintf("logtype = 'rule%d'", i),
+			"retention_policy":      "STANDARD",
+			"target_data_partition": fmt.Sprintf("Log_Rule_%d", i),
+		}), nil)
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(i int, diff *terraform.InstanceDiff) {
+			defer wg.Done()
+			states[i], diags[i] = r.Apply(context.Background(), nil, diff, meta)
+		}(i, diff)
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(concurrency), atomic.LoadInt32(&maxSeen))
+
+	// The API error is only reported by the rule that caused it
+	for i := 0; i < rules; i++ {
+		if i == 7 {
+			require.True(t, diags[i].HasError())
+			require.Equal(t, "Log_Rule_7 already exists", diags[i][0].Summary)
+			continue
+		}
+
+		require.False(t, diags[i].HasError(), "rule %d: %v", i, diags[i])
+		require.Equal(t, fmt.Sprintf("rule-Log_Rule_%d", i), states[i].ID)
+	}
+}
+
+func TestDataPartitionRuleLimiter_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	limiter := newDataPartitionRuleLimiter(1)
+	require.NoError(t, limiter.acquire(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, limiter.acquire(ctx), context.Canceled)
+
+	limiter.release()
+	require.NoError(t, limiter.acquire(context.Background()))
+
+	// A provider config built without a limiter isn't limited
+	var unlimited dataPartitionRuleLimiter
+	require.NoError(t, unlimited.acquire(ctx))
+	unlimited.release()
+}
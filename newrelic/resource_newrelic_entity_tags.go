@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 	nrErrors "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
@@ -46,22 +48,7 @@ func resourceNewRelicEntityTags() *schema.Resource {
 				MinItems:    1,
 				Required:    true,
 				Description: "A set of key-value pairs to represent a tag. For example: Team:TeamName",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"key": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The tag key.",
-						},
-						"values": {
-							Type:        schema.TypeSet,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							MinItems:    1,
-							Required:    true,
-							Description: "The tag values.",
-						},
-					},
-				},
+				Elem:        entityTagSchemaElem(),
 			},
 		},
 		Timeouts: &schema.ResourceTimeout{
@@ -70,6 +57,26 @@ func resourceNewRelicEntityTags() *schema.Resource {
 	}
 }
 
+// entityTagSchemaElem describes a tag key and its values, as managed through the tagging API.
+func entityTagSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The tag key.",
+			},
+			"values": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+				Description: "The tag values.",
+			},
+		},
+	}
+}
+
 func resourceNewRelicEntityTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -249,6 +256,20 @@ func expandEntityTagValues(values []interface{}) []string {
 }
 
 func flattenEntityTags(d *schema.ResourceData, tags []*entities.TaggingTagInput) error {
+	if err := d.Set("guid", d.Id()); err != nil {
+		return err
+	}
+
+	if err := d.Set("tag", flattenEntityTagList(tags)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flattenEntityTagList flattens the given tags into tag blocks, leaving out the default tags
+// New Relic adds to every entity.
+func flattenEntityTagList(tags []*entities.TaggingTagInput) []map[string]interface{} {
 	out := []map[string]interface{}{}
 	for _, t := range tags {
 		if stringInSlice(defaultTags, t.Key) {
@@ -262,15 +283,7 @@ func flattenEntityTags(d *schema.ResourceData, tags []*entities.TaggingTagInput)
 		out = append(out, m)
 	}
 
-	if err := d.Set("guid", d.Id()); err != nil {
-		return err
-	}
-
-	if err := d.Set("tag", out); err != nil {
-		return err
-	}
-
-	return nil
+	return out
 }
 
 func getTagKeys(tags []entities.TaggingTagInput) []string {
@@ -303,3 +316,210 @@ func getTag(tags []*entities.TaggingTagInput, key string) *entities.TaggingTagIn
 
 	return nil
 }
+
+// entityTagChanges holds the tagging API calls needed to move an entity from its previously
+// configured tags to the configured ones.
+type entityTagChanges struct {
+	add          []entities.TaggingTagInput
+	deleteValues []entities.TaggingTagValueInput
+	deleteKeys   []string
+}
+
+// diffEntityTags compares two tag sets by key. Keys that are no longer configured are deleted, new
+// keys are added, and keys whose values changed only have the changed values added or deleted,
+// leaving every other tag of the entity untouched.
+func diffEntityTags(oldTags []entities.TaggingTagInput, newTags []entities.TaggingTagInput) entityTagChanges {
+	changes := entityTagChanges{}
+
+	oldValues := make(map[string][]string, len(oldTags))
+	for _, t := range oldTags {
+		oldValues[t.Key] = t.Values
+	}
+
+	newValues := make(map[string][]string, len(newTags))
+	for _, t := range newTags {
+		newValues[t.Key] = t.Values
+
+		previous, ok := oldValues[t.Key]
+		if !ok {
+			changes.add = append(changes.add, entities.TaggingTagInput{Key: t.Key, Values: t.Values})
+			continue
+		}
+
+		if added := stringSliceDifference(t.Values, previous); len(added) > 0 {
+			changes.add = append(changes.add, entities.TaggingTagInput{Key: t.Key, Values: added})
+		}
+
+		for _, v := range stringSliceDifference(previous, t.Values) {
+			changes.deleteValues = append(changes.deleteValues, entities.TaggingTagValueInput{Key: t.Key, Value: v})
+		}
+	}
+
+	for _, t := range oldTags {
+		if _, ok := newValues[t.Key]; !ok {
+			changes.deleteKeys = append(changes.deleteKeys, t.Key)
+		}
+	}
+
+	return changes
+}
+
+// stringSliceDifference returns the values of a that are not in b.
+func stringSliceDifference(a []string, b []string) []string {
+	var out []string
+	for _, v := range a {
+		if !stringInSlice(b, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// entityTagger is the subset of the entities client used to update the tags of an entity.
+type entityTagger interface {
+	TaggingAddTagsToEntityWithContext(context.Context, common.EntityGUID, []entities.TaggingTagInput) (*entities.TaggingMutationResult, error)
+	TaggingDeleteTagFromEntityWithContext(context.Context, common.EntityGUID, []string) (*entities.TaggingMutationResult, error)
+	TaggingDeleteTagValuesFromEntityWithContext(context.Context, common.EntityGUID, []entities.TaggingTagValueInput) (*entities.TaggingMutationResult, error)
+}
+
+// updateEntityTags moves the tags of an entity managed by the given resource type from the old
+// configured tags to the new ones. Only the keys of either tag set are changed, so tags added
+// outside of the resource, e.g. by `newrelic_entity_tags`, are kept.
+func updateEntityTags(ctx context.Context, client entityTagger, resourceType string, guid common.EntityGUID, oldTags []entities.TaggingTagInput, newTags []entities.TaggingTagInput) error {
+	changes := diffEntityTags(oldTags, newTags)
+
+	log.Printf("[INFO] Updating tags of %s %s", resourceType, guid)
+
+	if len(changes.deleteKeys) > 0 {
+		result, err := client.TaggingDeleteTagFromEntityWithContext(ctx, guid, changes.deleteKeys)
+		if err := checkEntityTaggingResult(resourceType, result, err); err != nil {
+			return err
+		}
+	}
+
+	if len(changes.deleteValues) > 0 {
+		result, err := client.TaggingDeleteTagValuesFromEntityWithContext(ctx, guid, changes.deleteValues)
+		if err := checkEntityTaggingResult(resourceType, result, err); err != nil {
+			return err
+		}
+	}
+
+	if len(changes.add) > 0 {
+		result, err := client.TaggingAddTagsToEntityWithContext(ctx, guid, changes.add)
+		if err := checkEntityTaggingResult(resourceType, result, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkEntityTaggingResult(resourceType string, result *entities.TaggingMutationResult, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if result != nil && len(result.Errors) > 0 {
+		var errMessages string
+		for _, e := range result.Errors {
+			errMessages += "[" + string(e.Type) + ": " + e.Message + "]"
+		}
+
+		return fmt.Errorf("err: %s tagging failed: %s", resourceType, errMessages)
+	}
+
+	return nil
+}
+
+// Time to wait for tag changes to be visible on a tagged entity.
+var entityTagsTimeout = 1 * time.Minute
+
+// applyEntityTags updates the configured tags of an entity like updateEntityTags, then waits for
+// the changes to be visible on the entity so the following Read sees them.
+func applyEntityTags(ctx context.Context, client *newrelic.NewRelic, resourceType string, guid common.EntityGUID, oldTags []interface{}, newTags []interface{}) error {
+	previous := expandEntityTags(oldTags)
+	tags := expandEntityTags(newTags)
+
+	if err := updateEntityTags(ctx, &client.Entities, resourceType, guid, previous, tags); err != nil {
+		return err
+	}
+
+	deletedKeys := diffEntityTags(previous, tags).deleteKeys
+
+	return resource.RetryContext(ctx, entityTagsTimeout, func() *resource.RetryError {
+		current, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, guid)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error retrieving tags for %s %s: %s", resourceType, guid, err))
+		}
+
+		if !entityTagsApplied(convertTagTypes(current), tags, deletedKeys) {
+			return resource.RetryableError(fmt.Errorf("expected tags of %s %s to have been updated", resourceType, guid))
+		}
+
+		return nil
+	})
+}
+
+// entityTagsApplied returns whether the current tags of an entity have exactly the expected values
+// for each expected key, and none of the deleted keys. Other keys are ignored, as they're not managed
+// by the resource.
+func entityTagsApplied(current []*entities.TaggingTagInput, expected []entities.TaggingTagInput, deletedKeys []string) bool {
+	for _, t := range expected {
+		tag := getTag(current, t.Key)
+		if tag == nil || len(tag.Values) != len(t.Values) || !tagValuesExist(tag, t.Values) {
+			return false
+		}
+	}
+
+	for _, k := range deletedKeys {
+		if getTag(current, k) != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// configuredEntityTags returns the given entity tags whose key is set on the resource, so that a
+// resource only reads back the tag keys it manages.
+func configuredEntityTags(tags []map[string]interface{}, resourceTags []interface{}) []map[string]interface{} {
+	configured := getTagKeys(expandEntityTags(resourceTags))
+
+	out := []map[string]interface{}{}
+	for _, t := range tags {
+		if stringInSlice(configured, t["key"].(string)) {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+// mergeDefaultTags returns the given resource tags along with the provider's default tags. A tag set
+// on the resource takes precedence over a default tag with the same key.
+func mergeDefaultTags(providerConfig *ProviderConfig, resourceTags []interface{}) []interface{} {
+	merged := append([]interface{}{}, resourceTags...)
+
+	if providerConfig == nil {
+		return merged
+	}
+
+	configured := getTagKeys(expandEntityTags(resourceTags))
+
+	keys := []string{}
+	for k := range providerConfig.DefaultTags {
+		if !stringInSlice(configured, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		merged = append(merged, map[string]interface{}{
+			"key":    k,
+			"values": schema.NewSet(schema.HashString, []interface{}{providerConfig.DefaultTags[k]}),
+		})
+	}
+
+	return merged
+}
@@ -0,0 +1,172 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntityTag(key string, values ...string) map[string]interface{} {
+	set := schema.NewSet(schema.HashString, nil)
+	for _, v := range values {
+		set.Add(v)
+	}
+
+	return map[string]interface{}{"key": key, "values": set}
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	providerConfig := &ProviderConfig{DefaultTags: map[string]string{
+		"team":       "platform",
+		"managed_by": "terraform",
+	}}
+
+	cases := map[string]struct {
+		providerConfig *ProviderConfig
+		resourceTags   []interface{}
+		expected       []entities.TaggingTagInput
+	}{
+		"no default tags": {
+			providerConfig: &ProviderConfig{},
+			resourceTags:   []interface{}{testEntityTag("team", "synthetics")},
+			expected:       []entities.TaggingTagInput{{Key: "team", Values: []string{"synthetics"}}},
+		},
+		"no provider configuration": {
+			resourceTags: []interface{}{testEntityTag("team", "synthetics")},
+			expected:     []entities.TaggingTagInput{{Key: "team", Values: []string{"synthetics"}}},
+		},
+		"no resource tags": {
+			providerConfig: providerConfig,
+			expected: []entities.TaggingTagInput{
+				{Key: "managed_by", Values: []string{"terraform"}},
+				{Key: "team", Values: []string{"platform"}},
+			},
+		},
+		"resource tags are added": {
+			providerConfig: providerConfig,
+			resourceTags:   []interface{}{testEntityTag("environment", "staging", "production")},
+			expected: []entities.TaggingTagInput{
+				{Key: "environment", Values: []string{"production", "staging"}},
+				{Key: "managed_by", Values: []string{"terraform"}},
+				{Key: "team", Values: []string{"platform"}},
+			},
+		},
+		"resource tags take precedence": {
+			providerConfig: providerConfig,
+			resourceTags:   []interface{}{testEntityTag("team", "synthetics", "observability")},
+			expected: []entities.TaggingTagInput{
+				{Key: "team", Values: []string{"observability", "synthetics"}},
+				{Key: "managed_by", Values: []string{"terraform"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			merged := expandEntityTags(mergeDefaultTags(tc.providerConfig, tc.resourceTags))
+			require.Len(t, merged, len(tc.expected))
+
+			for i, tag := range tc.expected {
+				require.Equal(t, tag.Key, merged[i].Key)
+				require.ElementsMatch(t, tag.Values, merged[i].Values)
+			}
+		})
+	}
+}
+
+func TestEntityTagsApplied(t *testing.T) {
+	expected := []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"observability", "platform"}},
+	}
+
+	applied := []*entities.TaggingTagInput{
+		{Key: "accountId", Values: []string{"1"}},
+		{Key: "team", Values: []string{"platform", "observability"}},
+		{Key: "environment", Values: []string{"production"}},
+	}
+	require.True(t, entityTagsApplied(applied, expected, nil))
+
+	missingValue := []*entities.TaggingTagInput{
+		{Key: "team", Values: []string{"observability"}},
+	}
+	require.False(t, entityTagsApplied(missingValue, expected, nil))
+
+	notRemoved := []*entities.TaggingTagInput{
+		{Key: "team", Values: []string{"observability", "platform"}},
+		{Key: "owner", Values: []string{"jdoe"}},
+	}
+	require.False(t, entityTagsApplied(notRemoved, expected, []string{"owner"}))
+
+	require.True(t, entityTagsApplied([]*entities.TaggingTagInput{{Key: "guid", Values: []string{"MXxWSVp8REFTSEJPQVJEfDE"}}}, nil, []string{"owner"}))
+}
+
+func TestConfiguredEntityTags(t *testing.T) {
+	tags := []map[string]interface{}{
+		{"key": "team", "values": []string{"observability"}},
+		{"key": "environment", "values": []string{"production"}},
+	}
+
+	require.Equal(t, tags[:1], configuredEntityTags(tags, []interface{}{testEntityTag("team", "platform")}))
+	require.Empty(t, configuredEntityTags(tags, nil))
+}
+
+// Mock of the tagging API recording the tags added and deleted.
+type testEntityTagger struct {
+	added         []entities.TaggingTagInput
+	deletedKeys   []string
+	deletedValues []entities.TaggingTagValueInput
+	calls         int
+}
+
+func (m *testEntityTagger) TaggingAddTagsToEntityWithContext(ctx context.Context, guid common.EntityGUID, tags []entities.TaggingTagInput) (*entities.TaggingMutationResult, error) {
+	m.calls++
+	m.added = append(m.added, tags...)
+	return &entities.TaggingMutationResult{}, nil
+}
+
+func (m *testEntityTagger) TaggingDeleteTagFromEntityWithContext(ctx context.Context, guid common.EntityGUID, keys []string) (*entities.TaggingMutationResult, error) {
+	m.calls++
+	m.deletedKeys = append(m.deletedKeys, keys...)
+	return &entities.TaggingMutationResult{}, nil
+}
+
+func (m *testEntityTagger) TaggingDeleteTagValuesFromEntityWithContext(ctx context.Context, guid common.EntityGUID, values []entities.TaggingTagValueInput) (*entities.TaggingMutationResult, error) {
+	m.calls++
+	m.deletedValues = append(m.deletedValues, values...)
+	return &entities.TaggingMutationResult{}, nil
+}
+
+func TestDiffEntityTags(t *testing.T) {
+	changes := diffEntityTags(
+		[]entities.TaggingTagInput{
+			{Key: "team", Values: []string{"observability"}},
+			{Key: "environment", Values: []string{"production", "staging"}},
+			{Key: "owner", Values: []string{"jdoe"}},
+		},
+		[]entities.TaggingTagInput{
+			{Key: "team", Values: []string{"observability"}},
+			{Key: "environment", Values: []string{"staging", "development"}},
+			{Key: "service", Values: []string{"checkout"}},
+		},
+	)
+
+	require.Equal(t, []entities.TaggingTagInput{
+		{Key: "environment", Values: []string{"development"}},
+		{Key: "service", Values: []string{"checkout"}},
+	}, changes.add)
+	require.Equal(t, []entities.TaggingTagValueInput{{Key: "environment", Value: "production"}}, changes.deleteValues)
+	require.Equal(t, []string{"owner"}, changes.deleteKeys)
+
+	unchanged := diffEntityTags(
+		[]entities.TaggingTagInput{{Key: "team", Values: []string{"observability"}}},
+		[]entities.TaggingTagInput{{Key: "team", Values: []string{"observability"}}},
+	)
+	require.Equal(t, entityTagChanges{}, unchanged)
+}
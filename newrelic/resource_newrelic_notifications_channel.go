@@ -21,8 +21,9 @@ func resourceNewRelicNotificationChannel() *schema.Resource {
 		ReadContext:   resourceNewRelicNotificationChannelRead,
 		UpdateContext: resourceNewRelicNotificationChannelUpdate,
 		DeleteContext: resourceNewRelicNotificationChannelDelete,
+		CustomizeDiff: resourceNewRelicNotificationChannelCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceImportStateWithAccountID(),
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -80,6 +81,14 @@ func resourceNewRelicNotificationChannel() *schema.Resource {
 	}
 }
 
+func resourceNewRelicNotificationChannelCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("product") {
+		return nil
+	}
+
+	return validateNotificationChannelProduct(diff.Get("type").(string), diff.Get("product").(string))
+}
+
 func resourceNewRelicNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
 	providerConfig := meta.(*ProviderConfig)
@@ -153,6 +162,11 @@ func resourceNewRelicNotificationChannelUpdate(ctx context.Context, d *schema.Re
 	accountID := selectAccountID(providerConfig, d)
 	updatedContext := updateContextWithAccountID(ctx, accountID)
 	updateInput := expandNotificationChannelUpdate(d)
+	if updateInput.Properties != nil {
+		updateInput.Properties = appendMonitoringProperty(updateInput.Properties)
+	}
+
+	log.Printf("[INFO] Updating New Relic notification channel %v", d.Id())
 
 	channelResponse, err := client.Notifications.AiNotificationsUpdateChannelWithContext(updatedContext, accountID, updateInput, d.Id())
 	if err != nil {
@@ -176,12 +190,16 @@ func resourceNewRelicNotificationChannelDelete(ctx context.Context, d *schema.Re
 	accountID := selectAccountID(providerConfig, d)
 	updatedContext := updateContextWithAccountID(ctx, accountID)
 
-	channelResponse, err := client.Notifications.AiNotificationsDeleteChannelWithContext(updatedContext, accountID, d.Id())
+	// A channel referenced by a workflow cannot be deleted. Terraform destroys the workflow first
+	// when it references the channel by attribute; the delete is retried while that is settling.
+	responseErrors, err := deleteNotificationsEntity(updatedContext, d.Id(), func() (*notifications.AiNotificationsDeleteResponse, error) {
+		return client.Notifications.AiNotificationsDeleteChannelWithContext(updatedContext, accountID, d.Id())
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	errors := buildAiNotificationsResponseErrors(channelResponse.Errors)
+	errors := buildAiNotificationsResponseErrors(responseErrors)
 
 	if len(errors) > 0 {
 		for _, e := range errors {
@@ -222,6 +240,44 @@ func listValidNotificationsProductTypes() []string {
 	}
 }
 
+// The products each channel type can be used with.
+func notificationChannelProductsByType() map[string][]string {
+	iint := string(notifications.AiNotificationsProductTypes.IINT)
+	discussions := string(notifications.AiNotificationsProductTypes.DISCUSSIONS)
+	errorTracking := string(notifications.AiNotificationsProductTypes.ERROR_TRACKING)
+
+	return map[string][]string{
+		string(notifications.AiNotificationsChannelTypeTypes.WEBHOOK):                       {iint},
+		string(notifications.AiNotificationsChannelTypeTypes.EMAIL):                         {iint, discussions},
+		string(notifications.AiNotificationsChannelTypeTypes.SERVICENOW_INCIDENTS):          {iint},
+		string(notifications.AiNotificationsChannelTypeTypes.PAGERDUTY_ACCOUNT_INTEGRATION): {iint},
+		string(notifications.AiNotificationsChannelTypeTypes.PAGERDUTY_SERVICE_INTEGRATION): {iint},
+		string(notifications.AiNotificationsChannelTypeTypes.JIRA_CLASSIC):                  {iint, errorTracking},
+		string(notifications.AiNotificationsChannelTypeTypes.SLACK):                         {iint, errorTracking},
+		string(notifications.AiNotificationsChannelTypeTypes.SLACK_COLLABORATION):           {discussions, errorTracking},
+		string(notifications.AiNotificationsChannelTypeTypes.SLACK_LEGACY):                  {iint},
+		string(notifications.AiNotificationsChannelTypeTypes.MOBILE_PUSH):                   {iint},
+		string(notifications.AiNotificationsChannelTypeTypes.EVENT_BRIDGE):                  {iint},
+	}
+}
+
+// validateNotificationChannelProduct returns an error when the channel type can't be used with the
+// product. Unknown types are left to the type's own validation.
+func validateNotificationChannelProduct(channelType string, product string) error {
+	products, ok := notificationChannelProductsByType()[channelType]
+	if !ok {
+		return nil
+	}
+
+	for _, p := range products {
+		if p == product {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("product %q is not supported by channel type %q, must be one of: %s", product, channelType, strings.Join(products, ", "))
+}
+
 func isNotificationChannelNotFound(err diag.Diagnostic) bool {
 	return strings.Contains(err.Summary, "INVALID_PARAMETER") && strings.Contains(err.Summary, "does not correspond to any valid entity")
 }
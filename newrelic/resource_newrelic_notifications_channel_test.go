@@ -6,6 +6,7 @@ package newrelic
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
@@ -78,6 +79,60 @@ func TestNewRelicNotificationChannel_Webhook(t *testing.T) {
 	})
 }
 
+func TestNewRelicNotificationChannel_WebhookPropertyUpdate(t *testing.T) {
+	resourceName := "newrelic_notification_channel.foo"
+	rand := acctest.RandString(5)
+	rName := fmt.Sprintf("tf-notifications-test-%s", rand)
+	channelPropsAttr := `property {
+		key = "payload"
+		value = "{\n\t\"id\": \"%s\"\n}"
+		label = "Payload Template"
+	}
+	`
+	destinationPropsAttr := `property {
+		key = "url"
+		value = "https://webhook.site/"
+	}
+	`
+	var channelID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testNewRelicNotificationChannelConfig(
+					testAccountID,
+					rName,
+					string(notifications.AiNotificationsChannelTypeTypes.WEBHOOK),
+					fmt.Sprintf(channelPropsAttr, "test"),
+					destinationPropsAttr,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNotificationChannelExists(resourceName),
+					testAccCheckNewRelicNotificationChannelSameID(resourceName, &channelID),
+				),
+			},
+			// Update a single property in-place
+			{
+				Config: testNewRelicNotificationChannelConfig(
+					testAccountID,
+					rName,
+					string(notifications.AiNotificationsChannelTypeTypes.WEBHOOK),
+					fmt.Sprintf(channelPropsAttr, "test-updated"),
+					destinationPropsAttr,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNotificationChannelExists(resourceName),
+					testAccCheckNewRelicNotificationChannelSameID(resourceName, &channelID),
+				),
+			},
+		},
+	})
+}
+
 func TestNewRelicNotificationChannel_WebhookPropertyError(t *testing.T) {
 	rand := acctest.RandString(5)
 	rName := fmt.Sprintf("tf-notifications-test-%s", rand)
@@ -234,6 +289,63 @@ func TestNewRelicNotificationChannel_ImportChannel_WrongId(t *testing.T) {
 	})
 }
 
+func TestNewRelicNotificationChannel_ImportWithAccountID(t *testing.T) {
+	resourceName := "newrelic_notification_channel.foo"
+	rand := acctest.RandString(5)
+	rName := fmt.Sprintf("tf-notifications-test-%s", rand)
+	channelPropsAttr := `property {
+		key = "payload"
+		value = "{\n\t\"id\": \"test\"\n}"
+		label = "Payload Template"
+	}
+
+	property {
+		key = "url"
+		value = "https://webhook.site/"
+	}
+	`
+	destinationPropsAttr := `property {
+		key = "url"
+		value = "https://webhook.site/"
+	}
+	`
+	config := testNewRelicNotificationChannelConfig(
+		testAccountID,
+		rName,
+		string(notifications.AiNotificationsChannelTypeTypes.WEBHOOK),
+		channelPropsAttr,
+		destinationPropsAttr,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNotificationChannelExists(resourceName),
+				),
+			},
+			// Import using <account_id>:<channel_id> and keep the imported state
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				ImportStateIdFunc:  testAccNewRelicNotificationChannelImportStateIDFunc(resourceName, testAccountID),
+			},
+			// The imported state matches the configuration, so no changes are planned
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testNewRelicNotificationChannelConfig(accountID int, name string, notificationType string, channelProps string, destinationProps string) string {
 	return fmt.Sprintf(`
 resource "newrelic_notification_destination" "foo" {
@@ -273,6 +385,9 @@ func testAccNewRelicNotificationChannelDestroy(s *terraform.State) error {
 		var accountID int
 		id := r.Primary.ID
 		accountID = providerConfig.AccountID
+		if stateAccountID, err := strconv.Atoi(r.Primary.Attributes["account_id"]); err == nil && stateAccountID != 0 {
+			accountID = stateAccountID
+		}
 		filters := ai.AiNotificationsChannelFilter{
 			ID: id,
 		}
@@ -303,6 +418,9 @@ func testAccCheckNewRelicNotificationChannelExists(n string) resource.TestCheckF
 		var accountID int
 		id := rs.Primary.ID
 		accountID = providerConfig.AccountID
+		if stateAccountID, err := strconv.Atoi(rs.Primary.Attributes["account_id"]); err == nil && stateAccountID != 0 {
+			accountID = stateAccountID
+		}
 		filters := ai.AiNotificationsChannelFilter{
 			ID: id,
 		}
@@ -320,3 +438,35 @@ func testAccCheckNewRelicNotificationChannelExists(n string) resource.TestCheckF
 		return nil
 	}
 }
+
+// Stores the channel ID on the first call and verifies it is unchanged on subsequent calls.
+func testAccCheckNewRelicNotificationChannelSameID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if *id == "" {
+			*id = rs.Primary.ID
+			return nil
+		}
+
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("expected channel to be updated in-place, but ID changed from %s to %s", *id, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNewRelicNotificationChannelImportStateIDFunc(resourceName string, accountID int) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%d:%s", accountID, rs.Primary.ID), nil
+	}
+}
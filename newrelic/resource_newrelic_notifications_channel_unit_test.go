@@ -0,0 +1,131 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock of a Slack channel as returned by the API, with its token masked.
+const testNotificationSlackChannel = `{
+	"accountId": 1,
+	"active": true,
+	"destinationId": "0f7ad6c3-7a7d-4c34-9b4e-1d6a1b3c9e11",
+	"id": "b1e90a32-23b7-4028-b2c7-ffbdfe103852",
+	"name": "slack",
+	"product": "IINT",
+	"properties": [
+		{"key": "channelId", "value": "C01234567"},
+		{"key": "token", "value": "********"}
+	],
+	"status": "DEFAULT",
+	"type": "SLACK"
+}`
+
+// testMockNotificationChannelProviderConfig serves the Slack channel and records the channel sent
+// with every update.
+func testMockNotificationChannelProviderConfig(t *testing.T) (*ProviderConfig, *[]notifications.AiNotificationsChannelUpdate) {
+	var mu sync.Mutex
+	updates := []notifications.AiNotificationsChannelUpdate{}
+
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Channel notifications.AiNotificationsChannelUpdate `json:"channel"`
+			} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		if strings.Contains(body.Query, "aiNotificationsUpdateChannel") {
+			mu.Lock()
+			updates = append(updates, body.Variables.Channel)
+			mu.Unlock()
+
+			_, _ = w.Write([]byte(`{"data":{"aiNotificationsUpdateChannel":{"channel":` + testNotificationSlackChannel + `,"errors":[]}}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"aiNotifications":{"channels":{"entities":[` + testNotificationSlackChannel + `],"errors":[],"totalCount":1}}}}}}`))
+	})
+
+	return meta, &updates
+}
+
+func testNotificationSlackChannelConfig(name string, token string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":           name,
+		"type":           "SLACK",
+		"product":        "IINT",
+		"destination_id": "0f7ad6c3-7a7d-4c34-9b4e-1d6a1b3c9e11",
+		"property": []interface{}{
+			map[string]interface{}{"key": "channelId", "value": "C01234567"},
+			map[string]interface{}{"key": "token", "value": token},
+		},
+	}
+}
+
+// Applies the given configuration to the Slack channel, read back with the token set in its state.
+func testApplyNotificationSlackChannel(t *testing.T, meta *ProviderConfig, config map[string]interface{}) *terraform.InstanceDiff {
+	r := resourceNewRelicNotificationChannel()
+	d := r.TestResourceData()
+	d.SetId("b1e90a32-23b7-4028-b2c7-ffbdfe103852")
+	require.NoError(t, d.Set("property", testNotificationSlackChannelConfig("slack", "xoxb-secret")["property"]))
+	require.False(t, resourceNewRelicNotificationChannelRead(context.Background(), d, meta).HasError())
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), meta)
+	require.NoError(t, err)
+
+	if diff != nil && !diff.Empty() {
+		_, diags := r.Apply(context.Background(), d.State(), diff, meta)
+		require.False(t, diags.HasError())
+	}
+
+	return diff
+}
+
+func TestNotificationChannelSlackToken_UnchangedIsNotUpdated(t *testing.T) {
+	t.Parallel()
+
+	meta, updates := testMockNotificationChannelProviderConfig(t)
+
+	diff := testApplyNotificationSlackChannel(t, meta, testNotificationSlackChannelConfig("slack", "xoxb-secret"))
+
+	require.True(t, diff == nil || diff.Empty())
+	require.Empty(t, *updates)
+}
+
+func TestNotificationChannelSlackToken_NotResentOnRename(t *testing.T) {
+	t.Parallel()
+
+	meta, updates := testMockNotificationChannelProviderConfig(t)
+
+	testApplyNotificationSlackChannel(t, meta, testNotificationSlackChannelConfig("slack-renamed", "xoxb-secret"))
+
+	require.Len(t, *updates, 1)
+	require.Equal(t, "slack-renamed", (*updates)[0].Name)
+	require.Empty(t, (*updates)[0].Properties)
+}
+
+func TestNotificationChannelSlackToken_ResentOnChange(t *testing.T) {
+	t.Parallel()
+
+	meta, updates := testMockNotificationChannelProviderConfig(t)
+
+	testApplyNotificationSlackChannel(t, meta, testNotificationSlackChannelConfig("slack", "xoxb-rotated"))
+
+	require.Len(t, *updates, 1)
+	require.Contains(t, (*updates)[0].Properties, notifications.AiNotificationsPropertyInput{Key: "token", Value: "xoxb-rotated"})
+	require.Contains(t, (*updates)[0].Properties, createMonitoringProperty())
+}
@@ -22,8 +22,9 @@ func resourceNewRelicNotificationDestination() *schema.Resource {
 		UpdateContext: resourceNewRelicNotificationDestinationUpdate,
 		DeleteContext: resourceNewRelicNotificationDestinationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceImportStateWithAccountID(),
 		},
+		CustomizeDiff: resourceNewRelicNotificationDestinationCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -63,9 +64,11 @@ func resourceNewRelicNotificationDestination() *schema.Resource {
 							Required: true,
 						},
 						"password": {
-							Type:      schema.TypeString,
-							Required:  true,
-							Sensitive: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							Description:      "Password for basic authentication. Leave empty to keep the password already set on the destination.",
+							DiffSuppressFunc: suppressNotificationDestinationRetainedPassword,
 						},
 					},
 				},
@@ -120,6 +123,45 @@ func resourceNewRelicNotificationDestination() *schema.Resource {
 	}
 }
 
+func resourceNewRelicNotificationDestinationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A password can only be left empty to keep the one already set on the destination
+	if diff.Id() == "" && diff.NewValueKnown("auth_basic") {
+		if err := validateNotificationDestinationAuthBasic(diff.Get("auth_basic").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	// Properties referencing other resources are not known until apply
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("property") {
+		return nil
+	}
+
+	return validateNotificationDestinationProperties(diff.Get("type").(string), diff.Get("property").(*schema.Set).List())
+}
+
+// validateNotificationDestinationAuthBasic returns an error when a new destination has basic
+// authentication without a password.
+func validateNotificationDestinationAuthBasic(authBasic []interface{}) error {
+	for _, a := range authBasic {
+		auth, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if password, _ := auth["password"].(string); password == "" {
+			return fmt.Errorf("auth_basic.0.password is required when creating a notification destination")
+		}
+	}
+
+	return nil
+}
+
+// suppressNotificationDestinationRetainedPassword treats an empty password as keeping the one in
+// state, so the password is only sent again when it is rotated.
+func suppressNotificationDestinationRetainedPassword(k, old, new string, d *schema.ResourceData) bool {
+	return new == "" && old != ""
+}
+
 func resourceNewRelicNotificationDestinationV0() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNewRelicNotificationDestinationCreate,
@@ -333,12 +375,16 @@ func resourceNewRelicNotificationDestinationDelete(ctx context.Context, d *schem
 	accountID := selectAccountID(providerConfig, d)
 	updatedContext := updateContextWithAccountID(ctx, accountID)
 
-	destinationResponse, err := client.Notifications.AiNotificationsDeleteDestinationWithContext(updatedContext, accountID, d.Id())
+	// A destination referenced by a channel cannot be deleted. Terraform destroys the channel first
+	// when it references the destination by attribute; the delete is retried while that is settling.
+	responseErrors, err := deleteNotificationsEntity(updatedContext, d.Id(), func() (*notifications.AiNotificationsDeleteResponse, error) {
+		return client.Notifications.AiNotificationsDeleteDestinationWithContext(updatedContext, accountID, d.Id())
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	errors := buildAiNotificationsResponseErrors(destinationResponse.Errors)
+	errors := buildAiNotificationsResponseErrors(responseErrors)
 	if len(errors) > 0 {
 		return errors
 	}
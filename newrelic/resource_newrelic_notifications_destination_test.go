@@ -5,6 +5,7 @@ package newrelic
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
@@ -101,6 +102,47 @@ func TestNewRelicNotificationDestination_TokenAuth(t *testing.T) {
 	})
 }
 
+func TestNewRelicNotificationDestination_ImportWithAccountID(t *testing.T) {
+	resourceName := "newrelic_notification_destination.foo"
+	rand := acctest.RandString(5)
+	rName := fmt.Sprintf("tf-notifications-test-%s", rand)
+
+	authAttr := `auth_basic {
+		user = "username"
+		password = "abc123"
+	}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvVars(t)
+			if testSubAccountID == 0 {
+				t.Skipf("NEW_RELIC_SUBACCOUNT_ID must be set to import a destination from another account")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicNotificationDestinationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create in a non-default account
+			{
+				Config: testNewRelicNotificationDestinationConfig(testSubAccountID, rName, authAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNotificationDestinationExists(resourceName),
+				),
+			},
+			// Import using <account_id>:<destination_id>
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNewRelicNotificationDestinationImportStateIDFunc(resourceName, testSubAccountID),
+				ImportStateVerifyIgnore: []string{
+					"auth_basic.0.password",
+				},
+			},
+		},
+	})
+}
+
 func testNewRelicNotificationDestinationConfig(accountID int, name string, auth string) string {
 	return fmt.Sprintf(`
 resource "newrelic_notification_destination" "foo" {
@@ -137,6 +179,9 @@ func testAccNewRelicNotificationDestinationDestroy(s *terraform.State) error {
 		var accountID int
 		id := r.Primary.ID
 		accountID = providerConfig.AccountID
+		if stateAccountID, err := strconv.Atoi(r.Primary.Attributes["account_id"]); err == nil && stateAccountID != 0 {
+			accountID = stateAccountID
+		}
 		filters := ai.AiNotificationsDestinationFilter{
 			ID: id,
 		}
@@ -175,6 +220,9 @@ func testAccCheckNewRelicNotificationDestinationExists(n string) resource.TestCh
 		var accountID int
 		id := rs.Primary.ID
 		accountID = providerConfig.AccountID
+		if stateAccountID, err := strconv.Atoi(rs.Primary.Attributes["account_id"]); err == nil && stateAccountID != 0 {
+			accountID = stateAccountID
+		}
 		filters := ai.AiNotificationsDestinationFilter{
 			ID: id,
 		}
@@ -193,3 +241,14 @@ func testAccCheckNewRelicNotificationDestinationExists(n string) resource.TestCh
 		return nil
 	}
 }
+
+func testAccNewRelicNotificationDestinationImportStateIDFunc(resourceName string, accountID int) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%d:%s", accountID, rs.Primary.ID), nil
+	}
+}
@@ -0,0 +1,78 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock of the destinations query response for a destination failing to authenticate.
+const testNotificationDestinationsResponse = `{
+	"data": {
+		"actor": {
+			"account": {
+				"aiNotifications": {
+					"destinations": {
+						"entities": [
+							{
+								"accountId": 1,
+								"active": true,
+								"id": "7463c367-6d61-416b-9aac-47f4a285fe5a",
+								"isUserAuthenticated": true,
+								"lastSent": "2023-05-04T10:11:12.000Z",
+								"name": "webhook",
+								"properties": [{"key": "url", "value": "https://webhook.site/"}],
+								"status": "AUTHENTICATION_ERROR",
+								"type": "WEBHOOK"
+							}
+						],
+						"errors": [],
+						"totalCount": 1
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestResourceNewRelicNotificationDestinationRead_Status(t *testing.T) {
+	t.Parallel()
+
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testNotificationDestinationsResponse))
+	})
+
+	d := resourceNewRelicNotificationDestination().TestResourceData()
+	d.SetId("7463c367-6d61-416b-9aac-47f4a285fe5a")
+
+	diags := resourceNewRelicNotificationDestinationRead(context.Background(), d, meta)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "7463c367-6d61-416b-9aac-47f4a285fe5a", d.Id())
+	require.Equal(t, "AUTHENTICATION_ERROR", d.Get("status"))
+	require.Equal(t, "2023-05-04T10:11:12.000Z", d.Get("last_sent"))
+}
+
+func TestFlattenNotificationDestinationDataSource_Status(t *testing.T) {
+	t.Parallel()
+
+	d := dataSourceNewRelicNotificationDestination().TestResourceData()
+
+	err := flattenNotificationDestinationDataSource(&notifications.AiNotificationsDestination{
+		ID:       "7463c367-6d61-416b-9aac-47f4a285fe5a",
+		Name:     "webhook",
+		Type:     notifications.AiNotificationsDestinationTypeTypes.WEBHOOK,
+		Status:   notifications.AiNotificationsDestinationStatusTypes.THROTTLED,
+		LastSent: "2023-05-04T10:11:12.000Z",
+	}, d)
+	require.NoError(t, err)
+
+	require.Equal(t, "THROTTLED", d.Get("status"))
+	require.Equal(t, "2023-05-04T10:11:12.000Z", d.Get("last_sent"))
+}
@@ -103,6 +103,7 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImportStateWithMetadata(2, "type"),
 		},
+		CustomizeDiff: resourceNewRelicNrqlAlertConditionCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Type:        schema.TypeInt,
@@ -116,9 +117,10 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 				Description: "The title of the condition.",
 			},
 			"runbook_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Runbook URL to display in notifications.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Runbook URL to display in notifications.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
@@ -185,19 +187,17 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 			"critical": {
 				Type:          schema.TypeList,
 				MinItems:      1,
-				MaxItems:      1,
 				Optional:      true,
 				Elem:          termSchema(),
-				Description:   "A condition term with priority set to critical.",
+				Description:   "A list of condition terms with priority set to critical. Multiple terms are evaluated in the order given, e.g. to use different threshold durations.",
 				ConflictsWith: []string{"term"},
 			},
 			"warning": {
 				Type:          schema.TypeList,
 				MinItems:      1,
-				MaxItems:      1,
 				Optional:      true,
 				Elem:          termSchema(),
-				Description:   "A condition term with priority set to warning.",
+				Description:   "A list of condition terms with priority set to warning. Multiple terms are evaluated in the order given, e.g. to use different threshold durations.",
 				ConflictsWith: []string{"term"},
 			},
 			"violation_time_limit_seconds": {
@@ -356,6 +356,192 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 	}
 }
 
+// Bounds enforced by the API for the signal's aggregation window, in seconds.
+const (
+	nrqlConditionAggregationWindowDefault = 60
+	nrqlConditionAggregationWindowMin     = 30
+	nrqlConditionAggregationWindowMax     = 21600
+	nrqlConditionSlideByMin               = 30
+
+	nrqlConditionThresholdDurationMinStatic   = 60
+	nrqlConditionThresholdDurationMinBaseline = 120
+	nrqlConditionThresholdDurationMax         = 86400
+)
+
+func resourceNewRelicNrqlAlertConditionCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("type") && diff.NewValueKnown("baseline_direction") {
+		if err := validateNrqlConditionBaselineDirection(diff.Get("type").(string), diff.Get("baseline_direction").(string)); err != nil {
+			return err
+		}
+	}
+
+	if diff.NewValueKnown("open_violation_on_expiration") && diff.NewValueKnown("close_violations_on_expiration") {
+		err := validateNrqlConditionExpiration(
+			diff.Get("open_violation_on_expiration").(bool),
+			diff.Get("close_violations_on_expiration").(bool),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateNrqlConditionTerms(diff); err != nil {
+		return err
+	}
+
+	if !diff.NewValueKnown("aggregation_window") || !diff.NewValueKnown("slide_by") {
+		return nil
+	}
+
+	slideBy := diff.Get("slide_by").(int)
+	if slideBy == 0 {
+		return nil
+	}
+
+	// `aggregation_window` is computed, so fall back to the API default when it isn't configured yet.
+	aggregationWindow := diff.Get("aggregation_window").(int)
+	if aggregationWindow == 0 {
+		aggregationWindow = nrqlConditionAggregationWindowDefault
+	}
+
+	return validateNrqlConditionSlideBy(aggregationWindow, slideBy)
+}
+
+// validateNrqlConditionTerms validates every known term of the `critical`, `warning` and deprecated `term` blocks.
+func validateNrqlConditionTerms(diff *schema.ResourceDiff) error {
+	conditionType := ""
+	if diff.NewValueKnown("type") {
+		conditionType = diff.Get("type").(string)
+	}
+
+	// Terms are only checked against the aggregation window once it is known, defaulting like the API does.
+	aggregationWindow := 0
+	if diff.NewValueKnown("aggregation_window") {
+		aggregationWindow = diff.Get("aggregation_window").(int)
+		if aggregationWindow == 0 {
+			aggregationWindow = nrqlConditionAggregationWindowDefault
+		}
+	}
+
+	for _, block := range []string{"critical", "warning", "term"} {
+		if !diff.NewValueKnown(block) {
+			continue
+		}
+
+		var terms []interface{}
+		switch v := diff.Get(block).(type) {
+		case []interface{}:
+			terms = v
+		case *schema.Set:
+			terms = v.List()
+		}
+
+		for _, t := range terms {
+			term, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if err := validateNrqlConditionTerm(term, conditionType, aggregationWindow); err != nil {
+				return fmt.Errorf("invalid `%s` block: %w", block, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateNrqlConditionTerm checks that a term configures its duration and occurrences exactly once,
+// and that the duration is within the bounds of the condition type and spans whole aggregation windows.
+// An empty condition type or a zero aggregation window skips the checks depending on them.
+func validateNrqlConditionTerm(term map[string]interface{}, conditionType string, aggregationWindow int) error {
+	duration, _ := term["duration"].(int)
+	thresholdDuration, _ := term["threshold_duration"].(int)
+	timeFunction, _ := term["time_function"].(string)
+	thresholdOccurrences, _ := term["threshold_occurrences"].(string)
+
+	if duration == 0 && thresholdDuration == 0 {
+		return fmt.Errorf("one of `duration` or `threshold_duration` must be configured for block `term`")
+	}
+
+	if duration > 0 && thresholdDuration > 0 {
+		return fmt.Errorf("one of `duration` or `threshold_duration` must be configured for block `term`, but not both")
+	}
+
+	if timeFunction == "" && thresholdOccurrences == "" {
+		return fmt.Errorf("one of `time_function` or `threshold_occurrences` must be configured for block `term`")
+	}
+
+	if timeFunction != "" && thresholdOccurrences != "" {
+		return fmt.Errorf("one of `time_function` or `threshold_occurrences` must be configured for block `term`, but not both")
+	}
+
+	// `duration` is in minutes.
+	if thresholdDuration == 0 {
+		thresholdDuration = duration * 60
+	}
+
+	if conditionType != "" {
+		min := nrqlConditionThresholdDurationMinStatic
+		if strings.EqualFold(conditionType, "baseline") {
+			min = nrqlConditionThresholdDurationMinBaseline
+		}
+
+		if thresholdDuration < min || thresholdDuration > nrqlConditionThresholdDurationMax {
+			return fmt.Errorf("expected threshold_duration to be in the range (%d - %d) for %s conditions, got %d", min, nrqlConditionThresholdDurationMax, conditionType, thresholdDuration)
+		}
+	}
+
+	if aggregationWindow > 0 && thresholdDuration%aggregationWindow != 0 {
+		return fmt.Errorf("expected threshold_duration (%d) to be a multiple of aggregation_window (%d)", thresholdDuration, aggregationWindow)
+	}
+
+	return nil
+}
+
+// validateNrqlConditionBaselineDirection rejects `baseline_direction` on static conditions,
+// where the API ignores it and every plan would show a diff.
+func validateNrqlConditionBaselineDirection(conditionType string, baselineDirection string) error {
+	if baselineDirection != "" && !strings.EqualFold(conditionType, "baseline") {
+		return fmt.Errorf("attribute `baseline_direction` is only supported for nrql alert conditions of type `baseline`, got type `%s`", conditionType)
+	}
+
+	return nil
+}
+
+// validateNrqlConditionExpiration rejects contradictory expiration actions: an expired signal can
+// either close the open incidents or open a new incident, not both.
+func validateNrqlConditionExpiration(openOnExpiration bool, closeOnExpiration bool) error {
+	if openOnExpiration && closeOnExpiration {
+		return fmt.Errorf("attributes `open_violation_on_expiration` and `close_violations_on_expiration` are contradictory, only one of them can be enabled")
+	}
+
+	return nil
+}
+
+// validateNrqlConditionSlideBy checks that `slide_by` evenly divides `aggregation_window`
+// and that both values are within the bounds accepted by the API.
+func validateNrqlConditionSlideBy(aggregationWindow int, slideBy int) error {
+	if aggregationWindow < nrqlConditionAggregationWindowMin || aggregationWindow > nrqlConditionAggregationWindowMax {
+		return fmt.Errorf("expected aggregation_window to be in the range (%d - %d), got %d", nrqlConditionAggregationWindowMin, nrqlConditionAggregationWindowMax, aggregationWindow)
+	}
+
+	minSlideBy := nrqlConditionSlideByMin
+	if aggregationWindow > 3600 {
+		minSlideBy = aggregationWindow / 120
+	}
+
+	if slideBy < minSlideBy || slideBy >= aggregationWindow {
+		return fmt.Errorf("expected slide_by to be at least %d and less than aggregation_window (%d), got %d", minSlideBy, aggregationWindow, slideBy)
+	}
+
+	if aggregationWindow%slideBy != 0 {
+		return fmt.Errorf("expected slide_by (%d) to be a factor of aggregation_window (%d)", slideBy, aggregationWindow)
+	}
+
+	return nil
+}
+
 func resourceNewRelicNrqlAlertConditionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -28,6 +28,8 @@ func TestAccNewRelicNrqlAlertCondition_Basic(t *testing.T) {
 				Config: testAccNewRelicNrqlAlertConditionConfigBasic(rName, "120", "120", "sTaTiC", "0", "", "60", "30", "259200"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "runbook_url", "https://foo.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "description", "tf-test description"),
 				),
 			},
 			// Test: Update
@@ -565,6 +567,35 @@ func TestAccNewRelicNrqlAlertCondition_StaticConditionEvaluationDelay(t *testing
 	})
 }
 
+func TestAccNewRelicNrqlAlertCondition_MultipleCriticalTerms(t *testing.T) {
+	resourceName := "newrelic_nrql_alert_condition.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicNrqlAlertConditionDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicNrqlAlertConditionMultipleCriticalTerms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "critical.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "critical.0.threshold_duration", "120"),
+					resource.TestCheckResourceAttr(resourceName, "critical.1.threshold_duration", "600"),
+					resource.TestCheckResourceAttr(resourceName, "warning.#", "1"),
+				),
+			},
+			// Test: No diff
+			{
+				Config:   testAccNewRelicNrqlAlertConditionMultipleCriticalTerms(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckNewRelicNrqlAlertConditionDestroy(s *terraform.State) error {
 	providerConfig := testAccProvider.Meta().(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -665,6 +696,7 @@ resource "newrelic_nrql_alert_condition" "foo" {
 
   name                           = "tf-test-%[1]s"
   runbook_url                    = "https://foo.example.com"
+  description                    = "tf-test description"
   enabled                        = false
   fill_option                    = "%[4]s"
   fill_value                     = %[5]s
@@ -673,7 +705,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
   aggregation_delay              = %[2]s
 	aggregation_method             = "EVENT_FLOW"
   close_violations_on_expiration = true
-  open_violation_on_expiration   = true
   expiration_duration            = 120
   violation_time_limit_seconds   = %[9]s
 
@@ -726,7 +757,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
   fill_option                    = "%[5]s"
 	fill_value                     = %[6]s
 	close_violations_on_expiration = true
-	open_violation_on_expiration   = true
 	expiration_duration            = 120
 	aggregation_window             = 60
 
@@ -785,7 +815,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
 	fill_option                    = "%[5]s"
 	fill_value                     = %[6]s
 	close_violations_on_expiration = true
-	open_violation_on_expiration   = true
 	expiration_duration            = 120
 	aggregation_window             = 60
 	aggregation_delay              = %[3]d
@@ -836,7 +865,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
 	description                    = "test description"
 	violation_time_limit_seconds   = 3600
 	close_violations_on_expiration = true
-	open_violation_on_expiration   = true
 	expiration_duration            = 120
 	aggregation_window             = 60
 
@@ -869,7 +897,7 @@ resource "newrelic_nrql_alert_condition" "foo" {
     policy_id                      = newrelic_alert_policy.foo.id
     name                           = "tf-test-%[1]s"
     description                    = "Test desc"
-    runbook_url                    = "REDACTED"
+    runbook_url                    = "https://foo.example.com"
     enabled                        = true
     violation_time_limit_seconds   = 86400
 
@@ -903,7 +931,7 @@ func testAccNewRelicNrqlAlertConditionSinceValue(
 	  type                           = "static"
       name                           = "tf-test-%[1]s"
 	  description                    = "Test desc"
-	  runbook_url                    = "REDACTED"
+	  runbook_url                    = "https://foo.example.com"
 	  enabled                        = true
 	  violation_time_limit_seconds   = 86400
 	  expiration_duration            = 120
@@ -944,7 +972,7 @@ func testAccNewRelicNrqlAlertConditionSinceValueUpdateWithStreamingMethods(
 	  type                           = "static"
       name                           = "tf-test-%[1]s"
 	  description                    = "Test desc"
-	  runbook_url                    = "REDACTED"
+	  runbook_url                    = "https://foo.example.com"
 	  enabled                        = true
 	  violation_time_limit_seconds   = 86400
 	  expiration_duration            = 120
@@ -995,7 +1023,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
 	description                    = "test description"
 	violation_time_limit_seconds   = 3600
 	close_violations_on_expiration = true
-	open_violation_on_expiration   = true
 	expiration_duration            = 120
 	aggregation_window             = 60
 
@@ -1037,7 +1064,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
   fill_option                    = "static"
   aggregation_window             = %[5]s
   close_violations_on_expiration = true
-  open_violation_on_expiration   = true
   expiration_duration            = 120
 	nrql {
     query             = "SELECT uniqueCount(hostname) FROM ComputeSample"
@@ -1079,7 +1105,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
 	description                    = "test description"
 	violation_time_limit_seconds   = 3600
 	close_violations_on_expiration = true
-	open_violation_on_expiration   = true
 	expiration_duration            = 120
  	aggregation_delay              = 120
 	aggregation_method             = "event_flow"
@@ -1117,7 +1142,6 @@ resource "newrelic_nrql_alert_condition" "foo" {
 	description                    = "test description"
 	violation_time_limit_seconds   = 3600
 	close_violations_on_expiration = true
-	open_violation_on_expiration   = true
 	expiration_duration            = 120
  	aggregation_delay              = 120
 	aggregation_method             = "event_flow"
@@ -1137,3 +1161,49 @@ resource "newrelic_nrql_alert_condition" "foo" {
 }
 `, name)
 }
+
+func testAccNewRelicNrqlAlertConditionMultipleCriticalTerms(
+	name string,
+) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+	name = "tf-test-%[1]s"
+}
+
+resource "newrelic_nrql_alert_condition" "foo" {
+	policy_id   = newrelic_alert_policy.foo.id
+
+	name                           = "tf-test-%[1]s"
+	type                           = "static"
+	enabled                        = false
+	violation_time_limit_seconds   = 3600
+	aggregation_method             = "event_flow"
+	aggregation_delay              = 120
+
+	nrql {
+		query = "SELECT uniqueCount(hostname) FROM ComputeSample"
+	}
+
+	critical {
+		operator              = "above"
+		threshold             = 10
+		threshold_duration    = 120
+		threshold_occurrences = "ALL"
+	}
+
+	critical {
+		operator              = "above"
+		threshold             = 5
+		threshold_duration    = 600
+		threshold_occurrences = "ALL"
+	}
+
+	warning {
+		operator              = "above"
+		threshold             = 2
+		threshold_duration    = 300
+		threshold_occurrences = "ALL"
+	}
+}
+`, name)
+}
@@ -0,0 +1,217 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNrqlConditionSlideBy(t *testing.T) {
+	cases := map[string]struct {
+		aggregationWindow int
+		slideBy           int
+		expectedErr       *regexp.Regexp
+	}{
+		"valid factor": {
+			aggregationWindow: 60,
+			slideBy:           30,
+		},
+		"valid large window": {
+			aggregationWindow: 7200,
+			slideBy:           60,
+		},
+		"valid maximum window": {
+			aggregationWindow: 21600,
+			slideBy:           180,
+		},
+		"not a factor": {
+			aggregationWindow: 120,
+			slideBy:           45,
+			expectedErr:       regexp.MustCompile(`expected slide_by \(45\) to be a factor of aggregation_window \(120\)`),
+		},
+		"equal to window": {
+			aggregationWindow: 60,
+			slideBy:           60,
+			expectedErr:       regexp.MustCompile(`expected slide_by to be at least 30 and less than aggregation_window \(60\), got 60`),
+		},
+		"below minimum": {
+			aggregationWindow: 60,
+			slideBy:           15,
+			expectedErr:       regexp.MustCompile(`expected slide_by to be at least 30 and less than aggregation_window \(60\), got 15`),
+		},
+		"below minimum for large window": {
+			aggregationWindow: 14400,
+			slideBy:           60,
+			expectedErr:       regexp.MustCompile(`expected slide_by to be at least 120 and less than aggregation_window \(14400\), got 60`),
+		},
+		"window too small": {
+			aggregationWindow: 15,
+			slideBy:           5,
+			expectedErr:       regexp.MustCompile(`expected aggregation_window to be in the range \(30 - 21600\), got 15`),
+		},
+		"window too large": {
+			aggregationWindow: 43200,
+			slideBy:           360,
+			expectedErr:       regexp.MustCompile(`expected aggregation_window to be in the range \(30 - 21600\), got 43200`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateNrqlConditionSlideBy(tc.aggregationWindow, tc.slideBy)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Regexp(t, tc.expectedErr, err.Error())
+		})
+	}
+}
+
+func TestValidateNrqlConditionBaselineDirection(t *testing.T) {
+	for _, direction := range []string{"LOWER_ONLY", "UPPER_ONLY", "UPPER_AND_LOWER", "upper_only"} {
+		require.NoError(t, validateNrqlConditionBaselineDirection("baseline", direction), direction)
+	}
+
+	require.NoError(t, validateNrqlConditionBaselineDirection("static", ""))
+
+	err := validateNrqlConditionBaselineDirection("static", "UPPER_ONLY")
+	require.EqualError(t, err, "attribute `baseline_direction` is only supported for nrql alert conditions of type `baseline`, got type `static`")
+}
+
+func TestNrqlConditionBaselineDirectionValidateFunc(t *testing.T) {
+	validateFunc := resourceNewRelicNrqlAlertCondition().Schema["baseline_direction"].ValidateFunc
+
+	for _, direction := range []string{"LOWER_ONLY", "UPPER_ONLY", "UPPER_AND_LOWER"} {
+		_, errs := validateFunc(direction, "baseline_direction")
+		require.Empty(t, errs, direction)
+	}
+
+	_, errs := validateFunc("SIDEWAYS", "baseline_direction")
+	require.Len(t, errs, 1)
+}
+
+func TestValidateNrqlConditionExpiration(t *testing.T) {
+	require.NoError(t, validateNrqlConditionExpiration(true, false))
+	require.NoError(t, validateNrqlConditionExpiration(false, true))
+	require.NoError(t, validateNrqlConditionExpiration(false, false))
+
+	require.EqualError(t, validateNrqlConditionExpiration(true, true), "attributes `open_violation_on_expiration` and `close_violations_on_expiration` are contradictory, only one of them can be enabled")
+}
+
+func TestValidateNrqlConditionTerm(t *testing.T) {
+	cases := map[string]struct {
+		term              map[string]interface{}
+		conditionType     string
+		aggregationWindow int
+		expectedErr       string
+	}{
+		"threshold duration and occurrences": {
+			term:              map[string]interface{}{"threshold_duration": 300, "threshold_occurrences": "ALL"},
+			conditionType:     "static",
+			aggregationWindow: 60,
+		},
+		"deprecated duration and time function": {
+			term:              map[string]interface{}{"duration": 5, "time_function": "any"},
+			conditionType:     "baseline",
+			aggregationWindow: 60,
+		},
+		"unknown type and aggregation window": {
+			term: map[string]interface{}{"threshold_duration": 90, "threshold_occurrences": "AT_LEAST_ONCE"},
+		},
+		"missing duration": {
+			term:        map[string]interface{}{"threshold_occurrences": "ALL"},
+			expectedErr: "one of `duration` or `threshold_duration` must be configured for block `term`",
+		},
+		"both durations": {
+			term:        map[string]interface{}{"duration": 5, "threshold_duration": 300, "threshold_occurrences": "ALL"},
+			expectedErr: "one of `duration` or `threshold_duration` must be configured for block `term`, but not both",
+		},
+		"missing occurrences": {
+			term:        map[string]interface{}{"threshold_duration": 300},
+			expectedErr: "one of `time_function` or `threshold_occurrences` must be configured for block `term`",
+		},
+		"both occurrences": {
+			term:        map[string]interface{}{"threshold_duration": 300, "time_function": "all", "threshold_occurrences": "ALL"},
+			expectedErr: "one of `time_function` or `threshold_occurrences` must be configured for block `term`, but not both",
+		},
+		"static duration too short": {
+			term:              map[string]interface{}{"threshold_duration": 30, "threshold_occurrences": "ALL"},
+			conditionType:     "static",
+			aggregationWindow: 30,
+			expectedErr:       "expected threshold_duration to be in the range (60 - 86400) for static conditions, got 30",
+		},
+		"baseline duration too short": {
+			term:              map[string]interface{}{"threshold_duration": 60, "threshold_occurrences": "ALL"},
+			conditionType:     "baseline",
+			aggregationWindow: 60,
+			expectedErr:       "expected threshold_duration to be in the range (120 - 86400) for baseline conditions, got 60",
+		},
+		"duration too long": {
+			term:              map[string]interface{}{"threshold_duration": 86460, "threshold_occurrences": "AT_LEAST_ONCE"},
+			conditionType:     "static",
+			aggregationWindow: 60,
+			expectedErr:       "expected threshold_duration to be in the range (60 - 86400) for static conditions, got 86460",
+		},
+		"duration not a multiple of the aggregation window": {
+			term:              map[string]interface{}{"threshold_duration": 300, "threshold_occurrences": "ALL"},
+			conditionType:     "static",
+			aggregationWindow: 120,
+			expectedErr:       "expected threshold_duration (300) to be a multiple of aggregation_window (120)",
+		},
+		"deprecated duration not a multiple of the aggregation window": {
+			term:              map[string]interface{}{"duration": 1, "time_function": "all"},
+			conditionType:     "static",
+			aggregationWindow: 900,
+			expectedErr:       "expected threshold_duration (60) to be a multiple of aggregation_window (900)",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateNrqlConditionTerm(tc.term, tc.conditionType, tc.aggregationWindow)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestNrqlConditionTermsCustomizeDiff(t *testing.T) {
+	r := resourceNewRelicNrqlAlertCondition()
+
+	config := func(aggregationWindow int, warningDuration int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"policy_id":          1,
+			"name":               "condition",
+			"type":               "static",
+			"aggregation_window": aggregationWindow,
+			"nrql": []interface{}{
+				map[string]interface{}{"query": "SELECT count(*) FROM Transaction"},
+			},
+			"critical": []interface{}{
+				map[string]interface{}{"threshold": 1.0, "threshold_duration": 600, "threshold_occurrences": "ALL"},
+			},
+			"warning": []interface{}{
+				map[string]interface{}{"threshold": 0.5, "threshold_duration": warningDuration, "threshold_occurrences": "AT_LEAST_ONCE"},
+			},
+		})
+	}
+
+	_, err := r.Diff(context.Background(), nil, config(300, 900), nil)
+	require.NoError(t, err)
+
+	_, err = r.Diff(context.Background(), nil, config(300, 120), nil)
+	require.EqualError(t, err, "invalid `warning` block: expected threshold_duration (120) to be a multiple of aggregation_window (300)")
+}
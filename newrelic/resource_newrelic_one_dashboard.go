@@ -2,11 +2,18 @@ package newrelic
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/accounts"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
 )
@@ -17,6 +24,7 @@ func resourceNewRelicOneDashboard() *schema.Resource {
 		ReadContext:   resourceNewRelicOneDashboardRead,
 		UpdateContext: resourceNewRelicOneDashboardUpdate,
 		DeleteContext: resourceNewRelicOneDashboardDelete,
+		CustomizeDiff: resourceNewRelicOneDashboardCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -52,6 +60,12 @@ func resourceNewRelicOneDashboard() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"private", "public_read_only", "public_read_write"}, false),
 				Description:  "Determines who can see or edit the dashboard. Valid values are private, public_read_only, public_read_write. Defaults to public_read_only.",
 			},
+			"tag": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of key-value pairs to tag the dashboard with. Only the configured tag keys are managed.",
+				Elem:        entityTagSchemaElem(),
+			},
 			// Computed
 			"guid": {
 				Type:        schema.TypeString,
@@ -69,10 +83,312 @@ func resourceNewRelicOneDashboard() *schema.Resource {
 				Description: "Dashboard-local variable definitions.",
 				Elem:        dashboardVariableSchemaElem(),
 			},
+			"validate_account_access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to check at plan time that every account queried by a widget can be accessed with the configured API key. This costs an extra API call on every plan.",
+			},
 		},
 	}
 }
 
+func resourceNewRelicOneDashboardCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Pages built from values that are not known yet are validated once they are.
+	if !diff.NewValueKnown("page") {
+		return nil
+	}
+
+	pages := diff.Get("page").([]interface{})
+	if err := validateDashboardPagesHaveWidgets(pages); err != nil {
+		return err
+	}
+
+	if err := validateDashboardBillboardThresholds(pages); err != nil {
+		return err
+	}
+
+	if err := validateDashboardWidgetColors(pages); err != nil {
+		return err
+	}
+
+	if err := validateDashboardWidgetQueries(pages, diff.NewValueKnown); err != nil {
+		return err
+	}
+
+	if !diff.Get("validate_account_access").(bool) {
+		return nil
+	}
+
+	return validateDashboardAccountAccess(ctx, meta.(*ProviderConfig).NewClient, dashboardWidgetQueryAccountIDs(pages))
+}
+
+// dashboardWidgetQueryAccountID is an account queried by a widget, and the path of that query.
+type dashboardWidgetQueryAccountID struct {
+	path      string
+	accountID int
+}
+
+// walkDashboardWidgets calls fn for every widget of the given pages, with the path of the widget, in
+// the order of the pages and, within a page, of the widget attributes. It stops at the first error
+// returned by fn.
+func walkDashboardWidgets(pages []interface{}, fn func(path string, page map[string]interface{}, widget map[string]interface{}) error) error {
+	for i, p := range pages {
+		page, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		keys := make([]string, 0, len(page))
+		for key := range page {
+			if strings.HasPrefix(key, "widget_") {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			widgets, _ := page[key].([]interface{})
+			for j, w := range widgets {
+				widget, ok := w.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if err := fn(fmt.Sprintf("page.%d.%s.%d", i, key, j), page, widget); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// dashboardWidgetQueryAccountIDs returns the account set on every widget NRQL query, in the order
+// of the pages and, within a page, of the widget attributes.
+func dashboardWidgetQueryAccountIDs(pages []interface{}) []dashboardWidgetQueryAccountID {
+	accountIDs := []dashboardWidgetQueryAccountID{}
+
+	_ = walkDashboardWidgets(pages, func(path string, page map[string]interface{}, widget map[string]interface{}) error {
+		queries, _ := widget["nrql_query"].([]interface{})
+		for k, q := range queries {
+			query, ok := q.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// Queries without an account run in the dashboard's account.
+			if accountID, _ := query["account_id"].(int); accountID != 0 {
+				accountIDs = append(accountIDs, dashboardWidgetQueryAccountID{
+					path:      fmt.Sprintf("%s.nrql_query.%d.account_id", path, k),
+					accountID: accountID,
+				})
+			}
+		}
+
+		return nil
+	})
+
+	return accountIDs
+}
+
+// validateDashboardAccountAccess returns an error naming the first widget query whose account is
+// not among the accounts the configured API key can access.
+func validateDashboardAccountAccess(ctx context.Context, client *newrelic.NewRelic, accountIDs []dashboardWidgetQueryAccountID) error {
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	scope := accounts.RegionScopeTypes.IN_REGION
+	accessible, err := client.Accounts.ListAccountsWithContext(ctx, accounts.ListAccountsParams{Scope: &scope})
+	if err != nil {
+		return fmt.Errorf("error listing the accounts accessible to validate widget accounts: %w", err)
+	}
+
+	accessibleIDs := make(map[int]bool, len(accessible))
+	for _, a := range accessible {
+		accessibleIDs[a.ID] = true
+	}
+
+	for _, a := range accountIDs {
+		if !accessibleIDs[a.accountID] {
+			return fmt.Errorf("%s: account %d is not accessible with the configured API key", a.path, a.accountID)
+		}
+	}
+
+	return nil
+}
+
+// validateDashboardPagesHaveWidgets returns an error naming the first page without any widget,
+// since the API rejects dashboard pages without widgets.
+func validateDashboardPagesHaveWidgets(pages []interface{}) error {
+	for i, p := range pages {
+		page, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if !dashboardPageHasWidgets(page) {
+			return fmt.Errorf("page %q (page.%d) must contain at least one widget", page["name"], i)
+		}
+	}
+
+	return nil
+}
+
+// validateDashboardBillboardThresholds checks the threshold values of every billboard widget,
+// including values that were not known yet when the configuration was validated.
+func validateDashboardBillboardThresholds(pages []interface{}) error {
+	for i, p := range pages {
+		page, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widgets, _ := page["widget_billboard"].([]interface{})
+		for j, w := range widgets {
+			widget, ok := w.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, severity := range []string{"critical", "warning"} {
+				value, _ := widget[severity].(string)
+				if _, errs := validateDashboardThresholdValue(value, fmt.Sprintf("page.%d.widget_billboard.%d.%s", i, j, severity)); len(errs) > 0 {
+					return errs[0]
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDashboardThresholdValue accepts an empty threshold, which is sent without a value, or a number.
+func validateDashboardThresholdValue(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a number, got %q", k, value)}
+	}
+
+	return nil, nil
+}
+
+// validateDashboardWidgetQueries returns an error naming the first widget with an empty NRQL query,
+// since the API rejects widgets with an empty query. Queries that are not known yet read as empty, so
+// they are skipped.
+func validateDashboardWidgetQueries(pages []interface{}, known func(key string) bool) error {
+	return walkDashboardWidgets(pages, func(path string, page map[string]interface{}, widget map[string]interface{}) error {
+		queries, _ := widget["nrql_query"].([]interface{})
+		for k, q := range queries {
+			query, ok := q.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			queryPath := fmt.Sprintf("%s.nrql_query.%d.query", path, k)
+			if nrql, _ := query["query"].(string); strings.TrimSpace(nrql) == "" && known(queryPath) {
+				return fmt.Errorf("widget %q on page %q (%s) must have a non-empty NRQL query", widget["title"], page["name"], queryPath)
+			}
+		}
+
+		return nil
+	})
+}
+
+var (
+	dashboardWidgetHexColorRegex        = regexp.MustCompile(`^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	dashboardWidgetFunctionalColorRegex = regexp.MustCompile(`^(?i)(rgb|rgba|hsl|hsla)\(.+\)$`)
+)
+
+// validateDashboardWidgetColors checks the colors of every widget and that no series is given
+// more than one color override, including values that were not known yet when the configuration was validated.
+func validateDashboardWidgetColors(pages []interface{}) error {
+	return walkDashboardWidgets(pages, func(path string, page map[string]interface{}, widget map[string]interface{}) error {
+		colors, _ := widget["colors"].([]interface{})
+		for k, c := range colors {
+			if err := validateDashboardWidgetColorBlock(c, fmt.Sprintf("%s.colors.%d", path, k)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func validateDashboardWidgetColorBlock(c interface{}, k string) error {
+	colors, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if _, errs := validateDashboardWidgetColor(colors["color"], k+".color"); len(errs) > 0 {
+		return errs[0]
+	}
+
+	seriesNames := map[string]bool{}
+	overrides, _ := colors["series_overrides"].([]interface{})
+	for i, o := range overrides {
+		override, ok := o.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if _, errs := validateDashboardWidgetColor(override["color"], fmt.Sprintf("%s.series_overrides.%d.color", k, i)); len(errs) > 0 {
+			return errs[0]
+		}
+
+		seriesName, _ := override["series_name"].(string)
+		if seriesName == "" {
+			continue
+		}
+
+		if seriesNames[seriesName] {
+			return fmt.Errorf("%s.series_overrides.%d: series %q already has a color override", k, i, seriesName)
+		}
+		seriesNames[seriesName] = true
+	}
+
+	return nil
+}
+
+// validateDashboardWidgetColor accepts an empty color, a hex color such as `#722727`, or an RGB or HSL color.
+func validateDashboardWidgetColor(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if value == "" || dashboardWidgetFunctionalColorRegex.MatchString(value) {
+		return nil, nil
+	}
+
+	if !dashboardWidgetHexColorRegex.MatchString(value) {
+		return nil, []error{fmt.Errorf("expected %s to be a hex color such as \"#722727\", or an RGB or HSL color, got %q", k, value)}
+	}
+
+	return nil, nil
+}
+
+func dashboardPageHasWidgets(page map[string]interface{}) bool {
+	hasWidgets := false
+
+	_ = walkDashboardWidgets([]interface{}{page}, func(path string, page map[string]interface{}, widget map[string]interface{}) error {
+		hasWidgets = true
+		return nil
+	})
+
+	return hasWidgets
+}
+
 func dashboardVariableSchemaElem() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -286,7 +602,7 @@ func dashboardWidgetSchemaBase() map[string]*schema.Schema {
 			Type:         schema.TypeInt,
 			Optional:     true,
 			Default:      3,
-			ValidateFunc: validation.IntAtLeast(1),
+			ValidateFunc: validation.IntBetween(1, 12),
 		},
 		"row": {
 			Type:     schema.TypeInt,
@@ -346,8 +662,9 @@ func dashboardWidgetColorSchemaElem() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"color": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDashboardWidgetColor,
 			},
 			"series_overrides": {
 				Type:     schema.TypeList,
@@ -355,9 +672,10 @@ func dashboardWidgetColorSchemaElem() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"color": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Color code",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Color code",
+							ValidateFunc: validateDashboardWidgetColor,
 						},
 						"series_name": {
 							Type:        schema.TypeString,
@@ -453,6 +771,12 @@ func dashboardWidgetNRQLQuerySchemaElem() *schema.Resource {
 func dashboardWidgetAreaSchemaElem() *schema.Resource {
 	s := dashboardWidgetSchemaBase()
 
+	s["y_axis_left_zero"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Specifies if the values on the graph to be rendered need to be fit to scale, or printed within the specified range.",
+	}
+
 	return &schema.Resource{
 		Schema: s,
 	}
@@ -473,15 +797,17 @@ func dashboardWidgetBillboardSchemaElem() *schema.Resource {
 	s := dashboardWidgetSchemaBase()
 
 	s["critical"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The critical threshold value.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The critical threshold value.",
+		ValidateFunc: validateDashboardThresholdValue,
 	}
 
 	s["warning"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The warning threshold value.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "The warning threshold value.",
+		ValidateFunc: validateDashboardThresholdValue,
 	}
 
 	return &schema.Resource{
@@ -663,6 +989,12 @@ func resourceNewRelicOneDashboardCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(string(guid))
 
+	if tags := d.Get("tag").(*schema.Set).List(); len(tags) > 0 {
+		if err := applyEntityTags(ctx, client, "newrelic_one_dashboard", guid, nil, tags); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	res := resourceNewRelicOneDashboardRead(ctx, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
@@ -710,7 +1042,17 @@ func resourceNewRelicOneDashboardRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	return diag.FromErr(flattenDashboardEntity(dashboard, d))
+	if err := flattenDashboardEntity(dashboard, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, common.EntityGUID(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only the configured tag keys are managed, the dashboard can have other tags
+	return diag.FromErr(d.Set("tag", configuredEntityTags(flattenEntityTagList(convertTagTypes(tags)), d.Get("tag").(*schema.Set).List())))
 }
 
 func resourceNewRelicOneDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -755,6 +1097,13 @@ func resourceNewRelicOneDashboardUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("err: newrelic_one_dashboard Update failed: %s", errMessages)
 	}
 
+	if d.HasChange("tag") {
+		o, n := d.GetChange("tag")
+		if err := applyEntityTags(ctx, client, "newrelic_one_dashboard", guid, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	diagErr := resourceNewRelicOneDashboardRead(ctx, d, meta)
 	if diagErr != nil {
 		return diagErr
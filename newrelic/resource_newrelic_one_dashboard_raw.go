@@ -155,7 +155,7 @@ func dashboardRawWidgetSchemaBase() map[string]*schema.Schema {
 			Type:         schema.TypeInt,
 			Optional:     true,
 			Default:      3,
-			ValidateFunc: validation.IntAtLeast(1),
+			ValidateFunc: validation.IntBetween(1, 12),
 		},
 		"row": {
 			Type:     schema.TypeInt,
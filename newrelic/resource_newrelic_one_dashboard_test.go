@@ -69,6 +69,39 @@ func TestAccNewRelicOneDashboard_CreateTwoPages(t *testing.T) {
 	})
 }
 
+// TestAccNewRelicOneDashboard_ImportTwoPages Ensures an imported dashboard rebuilds every page and widget so that
+// a plan against the imported state is empty
+func TestAccNewRelicOneDashboard_ImportTwoPages(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	config := testAccCheckNewRelicOneDashboardConfig_TwoPageMultipleWidgets(rName, strconv.Itoa(testAccountID))
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicOneDashboardDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicOneDashboardExists("newrelic_one_dashboard.bar", 0),
+				),
+			},
+			// Import and keep the imported state
+			{
+				ResourceName:       "newrelic_one_dashboard.bar",
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+			},
+			// Test: No diff against the imported state
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // TestAccNewRelicOneDashboard_CrossAccountQueries Ensures we can have different account IDs for NRQL queries
 func TestAccNewRelicOneDashboard_CrossAccountQueries(t *testing.T) {
 	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
@@ -263,7 +296,7 @@ func TestAccNewRelicOneDashboard_ChangeCheck(t *testing.T) {
 	})
 }
 
-// TestAccNewRelicOneDashboard_EmptyPage tests successful creation of a dashboard comprising a page with no widgets
+// TestAccNewRelicOneDashboard_EmptyPage tests that a dashboard comprising a page with no widgets is rejected at plan time
 func TestAccNewRelicOneDashboard_EmptyPage(t *testing.T) {
 	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
 	resource.ParallelTest(t, resource.TestCase{
@@ -273,16 +306,67 @@ func TestAccNewRelicOneDashboard_EmptyPage(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Test: Create
 			{
-				Config: testAccCheckNewRelicOneDashboardConfig_EmptyPage(rName),
+				Config:      testAccCheckNewRelicOneDashboardConfig_EmptyPage(rName),
+				ExpectError: regexp.MustCompile(`page "` + rName + `_page_one" \(page.0\) must contain at least one widget`),
+			},
+		},
+	})
+}
+
+// TestAccNewRelicOneDashboard_Tags tests applying, updating and removing tags on a dashboard
+func TestAccNewRelicOneDashboard_Tags(t *testing.T) {
+	resourceName := "newrelic_one_dashboard.bar"
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicOneDashboardDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create with tags
+			{
+				Config: testAccCheckNewRelicOneDashboardConfig_Tags(rName, `
+					tag {
+						key    = "team"
+						values = ["observability"]
+					}`),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckNewRelicOneDashboardExists("newrelic_one_dashboard.bar", 0),
+					testAccCheckNewRelicOneDashboardExists(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tag.*", map[string]string{"key": "team"}),
 				),
 			},
-			// Import
+			// Test: Update tags
 			{
-				ResourceName:      "newrelic_one_dashboard.bar",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccCheckNewRelicOneDashboardConfig_Tags(rName, `
+					tag {
+						key    = "team"
+						values = ["observability", "platform"]
+					}
+					tag {
+						key    = "environment"
+						values = ["production"]
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicOneDashboardExists(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tag.*", map[string]string{"key": "team", "values.#": "2"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tag.*", map[string]string{"key": "environment", "values.#": "1"}),
+				),
+			},
+			// Import, tags are only read for the configured keys
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tag"},
+			},
+			// Test: Remove tags
+			{
+				Config: testAccCheckNewRelicOneDashboardConfig_Tags(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicOneDashboardExists(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "0"),
+				),
 			},
 		},
 	})
@@ -478,6 +562,10 @@ func TestAccNewRelicOneDashboard_VariablesEnum(t *testing.T) {
 				Config: testAccCheckNewRelicOneDashboardConfig_OnePageFullVariablesEnumUpdated(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicOneDashboardExists("newrelic_one_dashboard.bar", 0),
+					resource.TestCheckResourceAttr("newrelic_one_dashboard.bar", "variable.0.item.#", "3"),
+					resource.TestCheckResourceAttr("newrelic_one_dashboard.bar", "variable.0.item.0.value", "ITEM"),
+					resource.TestCheckResourceAttr("newrelic_one_dashboard.bar", "variable.0.item.1.title", "second item"),
+					resource.TestCheckResourceAttr("newrelic_one_dashboard.bar", "variable.0.item.2.value", "THIRD"),
 				),
 			},
 			// Import
@@ -504,6 +592,50 @@ resource "newrelic_one_dashboard" "bar" {
 `
 }
 
+// testAccCheckNewRelicOneDashboardConfig_TwoPageMultipleWidgets generates a two page dashboard where the second page
+// holds several widgets of the same type
+func testAccCheckNewRelicOneDashboardConfig_TwoPageMultipleWidgets(dashboardName string, accountID string) string {
+	return `
+resource "newrelic_one_dashboard" "bar" {
+  name = "` + dashboardName + `"
+  permissions = "private"
+
+` + testAccCheckNewRelicOneDashboardConfig_PageFull(dashboardName, accountID) + `
+  page {
+    name = "Page 2"
+
+    widget_bar {
+      title = "bar widget lower"
+      row = 4
+      column = 1
+      nrql_query {
+        query      = "FROM Transaction SELECT count(*) FACET name"
+      }
+    }
+
+    widget_bar {
+      title = "bar widget upper"
+      row = 1
+      column = 1
+      nrql_query {
+        query      = "FROM Transaction SELECT count(*) FACET appName"
+      }
+    }
+
+    widget_line {
+      title = "line widget"
+      row = 1
+      column = 5
+      nrql_query {
+        account_id = ` + accountID + `
+        query      = "FROM Transaction SELECT average(duration) TIMESERIES"
+      }
+    }
+  }
+}
+`
+}
+
 // testAccCheckNewRelicOneDashboardConfig contains all the config options for a single page dashboard
 func testAccCheckNewRelicOneDashboardConfig_OnePageFull(dashboardName string, accountID string) string {
 	return `
@@ -528,6 +660,16 @@ func testAccCheckNewRelicOneDashboardConfig_EmptyPage(dashboardName string) stri
 		}`
 }
 
+func testAccCheckNewRelicOneDashboardConfig_Tags(dashboardName string, tags string) string {
+	return `
+		resource "newrelic_one_dashboard" "bar" {
+  			name = "` + dashboardName + `"
+  			permissions = "private"
+` + tags + `
+` + testAccCheckNewRelicOneDashboardConfig_PageSimple(dashboardName+"_page_one") + `
+		}`
+}
+
 func testAccCheckNewRelicOneDashboardConfig_OnePageFullVariablesNRQL(dashboardName string) string {
 	return `
 resource "newrelic_one_dashboard" "bar" {
@@ -848,6 +990,13 @@ func testAccCheckNewRelicOneDashboardConfig_VariableEnumUpdated() string {
 		title = "item"
 		value = "ITEM"
 	}
+	item {
+		title = "second item"
+		value = "SECOND"
+	}
+	item {
+		value = "THIRD"
+	}
     name = "variableUpdated"
 	replacement_strategy = "default"
 	title = "title"
@@ -0,0 +1,58 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDashboardAccountAccess(t *testing.T) {
+	// The API key used for the plan can only access accounts 1000 and 2000.
+	var requests int32
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write([]byte(`{"data":{"actor":{"accounts":[{"id":1000,"name":"parent"},{"id":2000,"name":"child"}]}}}`))
+	})
+	meta.AccountID = 1000
+
+	config := func(validate bool, accountIDs ...int) *terraform.ResourceConfig {
+		queries := []interface{}{}
+		for _, id := range accountIDs {
+			queries = append(queries, map[string]interface{}{"account_id": id, "query": "FROM Transaction SELECT count(*)"})
+		}
+
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                    "dashboard",
+			"validate_account_access": validate,
+			"page": []interface{}{
+				map[string]interface{}{
+					"name": "page",
+					"widget_line": []interface{}{
+						map[string]interface{}{"title": "line", "row": 1, "column": 1, "nrql_query": queries},
+					},
+				},
+			},
+		})
+	}
+
+	r := resourceNewRelicOneDashboard()
+
+	_, err := r.Diff(context.Background(), nil, config(true, 1000, 2000), meta)
+	assert.NoError(t, err)
+
+	_, err = r.Diff(context.Background(), nil, config(true, 1000, 3000), meta)
+	assert.EqualError(t, err, "page.0.widget_line.0.nrql_query.1.account_id: account 3000 is not accessible with the configured API key")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// The accounts aren't looked up unless the validation is enabled.
+	_, err = r.Diff(context.Background(), nil, config(false, 1000, 3000), meta)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
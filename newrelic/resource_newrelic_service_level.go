@@ -7,10 +7,13 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
 )
@@ -24,6 +27,7 @@ func resourceNewRelicServiceLevel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNewRelicServiceLevelCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"guid": {
 				Type:         schema.TypeString,
@@ -69,10 +73,88 @@ func resourceNewRelicServiceLevel() *schema.Resource {
 				Computed:    true,
 				Description: "",
 			},
+			"permalink": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL to the service level in New Relic.",
+			},
 		},
 	}
 }
 
+func resourceNewRelicServiceLevelCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Events built from values that are not known yet are validated once they are.
+	if !diff.NewValueKnown("events") {
+		return nil
+	}
+
+	return validateServiceLevelEvents(diff.Get("events").([]interface{}))
+}
+
+// validateServiceLevelEvents returns an error unless the events define exactly one of good_events
+// or bad_events next to valid_events, since the SLI is computed from one of them.
+func validateServiceLevelEvents(events []interface{}) error {
+	for _, e := range events {
+		cfg, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		goodEvents, _ := cfg["good_events"].([]interface{})
+		badEvents, _ := cfg["bad_events"].([]interface{})
+		hasGoodEvents := len(goodEvents) > 0
+		hasBadEvents := len(badEvents) > 0
+
+		if hasGoodEvents && hasBadEvents {
+			return fmt.Errorf("events must define only one of good_events or bad_events, not both")
+		}
+
+		if !hasGoodEvents && !hasBadEvents {
+			return fmt.Errorf("events must define one of good_events or bad_events alongside valid_events")
+		}
+
+		for _, query := range []string{"valid_events", "good_events", "bad_events"} {
+			if err := validateServiceLevelEventsSelect(query, cfg[query]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateServiceLevelEventsSelect returns an error when the select function of the events query
+// aggregates an attribute but none is given. COUNT counts the events themselves when no attribute is given.
+func validateServiceLevelEventsSelect(query string, q interface{}) error {
+	queries, _ := q.([]interface{})
+	for _, qq := range queries {
+		cfg, ok := qq.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		selects, _ := cfg["select"].([]interface{})
+		for _, ss := range selects {
+			sel, ok := ss.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			function, _ := sel["function"].(string)
+			attribute, _ := sel["attribute"].(string)
+			if function != "COUNT" && strings.TrimSpace(attribute) == "" {
+				return fmt.Errorf("%s select function %s requires an attribute", query, function)
+			}
+		}
+	}
+
+	return nil
+}
+
+func listValidServiceLevelSelectFunctions() []string {
+	return []string{"COUNT", "SUM", "GET_CDF_COUNT", "GET_FIELD"}
+}
+
 func eventsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -151,7 +233,7 @@ func eventsQuerySelectSchema() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				Description:  "",
-				ValidateFunc: validation.StringInSlice([]string{"COUNT", "SUM", "GET_CDF_COUNT", "GET_FIELD"}, false),
+				ValidateFunc: validation.StringInSlice(listValidServiceLevelSelectFunctions(), false),
 			},
 			"threshold": {
 				Type:        schema.TypeFloat,
@@ -257,6 +339,25 @@ func resourceNewRelicServiceLevelCreate(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("sli_id", created.ID)
 	_ = d.Set("sli_guid", sliGUID)
 
+	// The SLI entity is indexed shortly after the SLI is created
+	retryErr := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
+		permalink, err := getServiceLevelPermalink(ctx, client, sliGUID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if permalink == "" {
+			return resource.RetryableError(fmt.Errorf("service level entity %s not found", sliGUID))
+		}
+
+		_ = d.Set("permalink", permalink)
+		return nil
+	})
+
+	if retryErr != nil {
+		log.Printf("[WARN] Unable to read permalink of service level %s: %s", sliGUID, retryErr)
+	}
+
 	return diag.FromErr(flattenServiceLevelIndicator(*created, &identifier, d, sliGUID))
 }
 
@@ -278,6 +379,16 @@ func resourceNewRelicServiceLevelRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	permalink, err := getServiceLevelPermalink(ctx, client, sliGUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the known permalink while the SLI entity is not indexed
+	if permalink != "" {
+		_ = d.Set("permalink", permalink)
+	}
+
 	for _, indicator := range *indicators {
 		if indicator.ID == identifier.ID {
 			return diag.FromErr(flattenServiceLevelIndicator(indicator, identifier, d, sliGUID))
@@ -348,6 +459,27 @@ func parseIdentifier(ids string) (*serviceLevelIdentifier, error) {
 	}, nil
 }
 
+// Returns the permalink of the SLI entity, or an empty string if the entity can't be found.
+func getServiceLevelPermalink(ctx context.Context, client *newrelic.NewRelic, sliGUID string) (string, error) {
+	entity, err := client.Entities.GetEntityWithContext(ctx, common.EntityGUID(sliGUID))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if entity == nil {
+		return "", nil
+	}
+
+	if e, ok := (*entity).(interface{ GetPermalink() string }); ok {
+		return e.GetPermalink(), nil
+	}
+
+	return "", nil
+}
+
 func getSliGUID(identifier *serviceLevelIdentifier) string {
 	rawGUID := fmt.Sprintf("%d|EXT|SERVICE_LEVEL|%s", identifier.AccountID, identifier.ID)
 	return base64.StdEncoding.WithPadding(base64.NoPadding).EncodeToString([]byte(rawGUID))
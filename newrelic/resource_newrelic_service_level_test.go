@@ -29,6 +29,8 @@ func TestAccNewRelicServiceLevel_Basic(t *testing.T) {
 				Config: testAccNewRelicServiceLevelConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicServiceLevelExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "sli_guid"),
+					resource.TestCheckResourceAttrSet(resourceName, "permalink"),
 				),
 			},
 			// Test: Update
@@ -42,6 +44,99 @@ func TestAccNewRelicServiceLevel_Basic(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicServiceLevel_UpdateNameInPlace(t *testing.T) {
+	resourceName := "newrelic_service_level.sli"
+	rName := generateNameForIntegrationTestResource()
+	var sliGUID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicServiceLevelDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicServiceLevelConfigNamed(rName, rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicServiceLevelExists(resourceName),
+					testAccCheckNewRelicServiceLevelSameGUID(resourceName, &sliGUID),
+				),
+			},
+			// Test: Rename and describe in-place
+			{
+				Config: testAccNewRelicServiceLevelConfigNamed(rName, rName+"-renamed", "description-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicServiceLevelExists(resourceName),
+					testAccCheckNewRelicServiceLevelSameGUID(resourceName, &sliGUID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description-updated"),
+				),
+			},
+		},
+	})
+}
+
+// Stores the SLI GUID on the first call and verifies it is unchanged on subsequent calls.
+func testAccCheckNewRelicServiceLevelSameGUID(n string, sliGUID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if *sliGUID == "" {
+			*sliGUID = rs.Primary.Attributes["sli_guid"]
+			return nil
+		}
+
+		if rs.Primary.Attributes["sli_guid"] != *sliGUID {
+			return fmt.Errorf("expected service level to be updated in-place, but its GUID changed from %s to %s", *sliGUID, rs.Primary.Attributes["sli_guid"])
+		}
+
+		return nil
+	}
+}
+
+func testAccNewRelicServiceLevelConfigNamed(name string, sliName string, description string) string {
+	return fmt.Sprintf(`
+resource "newrelic_workload" "workload" {
+	name = "%[2]s"
+	account_id = %[1]d
+	entity_search_query {
+		query = "tags.namespace like '%%App%%' "
+	}
+	scope_account_ids =  [%[1]d]
+}
+
+resource "newrelic_service_level" "sli" {
+	guid = newrelic_workload.workload.guid
+	name = "%[3]s"
+	description = "%[4]s"
+
+	events {
+		account_id = %[1]d
+		valid_events {
+			from = "Transaction"
+		}
+		good_events {
+			from = "Transaction"
+			where = "duration < 0.5"
+		}
+	}
+
+	objective {
+		target = 99.00
+		time_window {
+			rolling {
+				count = 7
+				unit = "DAY"
+			}
+		}
+	}
+}
+`, testAccountID, name, sliName, description)
+}
+
 func testAccNewRelicServiceLevelConfig(name string) string {
 	return fmt.Sprintf(`
 resource "newrelic_workload" "workload" {
@@ -0,0 +1,182 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateServiceLevelEvents(t *testing.T) {
+	validEvents := []interface{}{map[string]interface{}{"from": "Transaction"}}
+	goodEvents := []interface{}{map[string]interface{}{"from": "Transaction", "where": "duration < 0.1"}}
+	badEvents := []interface{}{map[string]interface{}{"from": "TransactionError"}}
+
+	cases := map[string]struct {
+		events      map[string]interface{}
+		expectedErr string
+	}{
+		"good events": {
+			events: map[string]interface{}{
+				"valid_events": validEvents,
+				"good_events":  goodEvents,
+				"bad_events":   []interface{}{},
+			},
+		},
+		"bad events": {
+			events: map[string]interface{}{
+				"valid_events": validEvents,
+				"good_events":  []interface{}{},
+				"bad_events":   badEvents,
+			},
+		},
+		"missing": {
+			events: map[string]interface{}{
+				"valid_events": validEvents,
+				"good_events":  []interface{}{},
+				"bad_events":   []interface{}{},
+			},
+			expectedErr: "events must define one of good_events or bad_events alongside valid_events",
+		},
+		"both present": {
+			events: map[string]interface{}{
+				"valid_events": validEvents,
+				"good_events":  goodEvents,
+				"bad_events":   badEvents,
+			},
+			expectedErr: "events must define only one of good_events or bad_events, not both",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateServiceLevelEvents([]interface{}{tc.events})
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateServiceLevelEventsSelect(t *testing.T) {
+	query := func(function string, attribute string) []interface{} {
+		sel := map[string]interface{}{"function": function, "attribute": attribute}
+		return []interface{}{map[string]interface{}{"from": "Transaction", "select": []interface{}{sel}}}
+	}
+
+	cases := map[string]struct {
+		query       []interface{}
+		expectedErr string
+	}{
+		"no select": {
+			query: []interface{}{map[string]interface{}{"from": "Transaction"}},
+		},
+		"count without attribute": {
+			query: query("COUNT", ""),
+		},
+		"count with attribute": {
+			query: query("COUNT", "duration"),
+		},
+		"sum with attribute": {
+			query: query("SUM", "duration"),
+		},
+		"cdf count with attribute": {
+			query: query("GET_CDF_COUNT", "duration"),
+		},
+		"sum without attribute": {
+			query:       query("SUM", ""),
+			expectedErr: "good_events select function SUM requires an attribute",
+		},
+		"field with blank attribute": {
+			query:       query("GET_FIELD", " "),
+			expectedErr: "good_events select function GET_FIELD requires an attribute",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateServiceLevelEventsSelect("good_events", tc.query)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestServiceLevelSelectFunctionValidation(t *testing.T) {
+	validate := eventsQuerySelectSchema().Schema["function"].ValidateFunc
+
+	for _, function := range listValidServiceLevelSelectFunctions() {
+		_, errs := validate(function, "function")
+		require.Empty(t, errs, function)
+	}
+
+	for _, function := range []string{"count", "AVERAGE", "PERCENTILE"} {
+		_, errs := validate(function, "function")
+		require.Len(t, errs, 1, function)
+	}
+}
+
+func testServiceLevelConfig(name string, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"guid":        "MTIzNDU2N3xOUjF8V09SS0xPQUR8MTIzNA",
+		"name":        name,
+		"description": description,
+		"events": []interface{}{
+			map[string]interface{}{
+				"account_id": 1,
+				"valid_events": []interface{}{
+					map[string]interface{}{"from": "Transaction"},
+				},
+				"good_events": []interface{}{
+					map[string]interface{}{"from": "Transaction", "where": "duration < 0.5"},
+				},
+			},
+		},
+		"objective": []interface{}{
+			map[string]interface{}{
+				"target": 99.0,
+				"time_window": []interface{}{
+					map[string]interface{}{
+						"rolling": []interface{}{
+							map[string]interface{}{"count": 7, "unit": "DAY"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestServiceLevelNameAndDescriptionUpdateInPlace(t *testing.T) {
+	r := resourceNewRelicServiceLevel()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, testServiceLevelConfig("sli", "description"))
+	d.SetId("MTIzNDU2N3xOUjF8V09SS0xPQUR8MTIzNA:MTIzNDU2N3xFWFR8U0VSVklDRV9MRVZFTHwx")
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(testServiceLevelConfig("sli-renamed", "description-updated")), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	require.Contains(t, diff.Attributes, "name")
+	require.Contains(t, diff.Attributes, "description")
+
+	updated, err := schema.InternalMap(r.Schema).Data(d.State(), diff)
+	require.NoError(t, err)
+	require.Equal(t, d.Id(), updated.Id())
+
+	updateInput := expandServiceLevelUpdateInput(updated)
+	require.Equal(t, "sli-renamed", updateInput.Name)
+	require.Equal(t, "description-updated", updateInput.Description)
+}
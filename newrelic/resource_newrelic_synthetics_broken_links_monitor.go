@@ -83,7 +83,7 @@ func resourceNewRelicSyntheticsBrokenLinksMonitorRead(ctx context.Context, d *sc
 	}
 
 	// This should probably be in go-client so we can use *errors.NotFound
-	if *resp == nil {
+	if isSyntheticsMonitorEntityDeleted(resp) {
 		d.SetId("")
 		return nil
 	}
@@ -125,6 +125,10 @@ func resourceNewRelicSyntheticsBrokenLinksMonitorUpdate(ctx context.Context, d *
 		return errors
 	}
 
+	if err := updateSyntheticsMonitorTags(ctx, &client.Entities, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = setSyntheticsMonitorAttributes(d, map[string]string{
 		"guid":   string(resp.Monitor.GUID),
 		"name":   resp.Monitor.Name,
@@ -147,10 +151,11 @@ func resourceNewRelicSyntheticsBrokenLinksMonitorDelete(ctx context.Context, d *
 
 	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
 
-	_, err := client.Synthetics.SyntheticsDeleteMonitorWithContext(ctx, guid)
-	if err != nil {
+	if err := deleteSyntheticsMonitor(ctx, &client.Synthetics, guid); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diag.FromErr(err)
+	d.SetId("")
+
+	return nil
 }
@@ -161,7 +161,7 @@ func resourceNewRelicSyntheticsCertCheckMonitorRead(ctx context.Context, d *sche
 	}
 
 	// This should probably be in go-client so we can use *errors.NotFound
-	if *resp == nil {
+	if isSyntheticsMonitorEntityDeleted(resp) {
 		d.SetId("")
 		return nil
 	}
@@ -204,6 +204,11 @@ func resourceNewRelicSyntheticsCertCheckMonitorUpdate(ctx context.Context, d *sc
 				Summary:  fmt.Sprintf("%s: %s", string(err.Type), err.Description),
 			})
 		}
+		return diags
+	}
+
+	if err := updateSyntheticsMonitorTags(ctx, &client.Entities, d); err != nil {
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("certificate_expiration", resp.Monitor.NumberDaysToFailBeforeCertExpires)
@@ -234,11 +239,12 @@ func resourceNewRelicSyntheticsCertCheckMonitorDelete(ctx context.Context, d *sc
 
 	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
 
-	_, err := client.Synthetics.SyntheticsDeleteMonitorWithContext(ctx, guid)
-	if err != nil {
+	if err := deleteSyntheticsMonitor(ctx, &client.Synthetics, guid); err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId("")
+
 	return nil
 }
 
@@ -277,7 +283,6 @@ func buildSyntheticsCertCheckMonitorUpdateInput(d *schema.ResourceData) (result
 		Name:   inputBase.Name,
 		Period: inputBase.Period,
 		Status: inputBase.Status,
-		Tags:   inputBase.Tags,
 	}
 
 	if v, ok := d.GetOk("locations_public"); ok {
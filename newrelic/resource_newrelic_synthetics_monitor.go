@@ -25,6 +25,7 @@ func resourceNewRelicSyntheticsMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNewRelicSyntheticsMonitorRuntimeCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -57,9 +58,10 @@ func resourceNewRelicSyntheticsMonitor() *schema.Resource {
 				Description: "The interval in minutes at which this monitor should run.",
 			},
 			"uri": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The URI for the monitor to hit.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The URI for the monitor to hit. Must be an absolute http or https URL.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"locations_public": {
 				Type:         schema.TypeSet,
@@ -72,10 +74,15 @@ func resourceNewRelicSyntheticsMonitor() *schema.Resource {
 			"locations_private": {
 				Type:         schema.TypeSet,
 				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          hashSyntheticsPrivateLocation,
 				MinItems:     1,
 				Optional:     true,
 				AtLeastOneOf: []string{"locations_public", "locations_private"},
-				Description:  "The locations in which this monitor should be run.",
+				Description:  "The private location GUIDs or domain IDs in which this monitor should be run.",
+				// A domain ID and its GUID refer to the same private location
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return syntheticsPrivateLocationDomainID(old) == syntheticsPrivateLocationDomainID(new)
+				},
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -187,9 +194,11 @@ func resourceNewRelicSyntheticsMonitorCreate(ctx context.Context, d *schema.Reso
 	switch monitorType.(string) {
 	case string(SyntheticsMonitorTypes.SIMPLE):
 		simpleMonitorInput := buildSyntheticsSimpleMonitor(d)
+		simpleMonitorInput.Locations.Private = expandSyntheticsPrivateLocationGUIDs(simpleMonitorInput.Locations.Private, accountID)
 		resp, err = client.Synthetics.SyntheticsCreateSimpleMonitorWithContext(ctx, accountID, simpleMonitorInput)
 	case string(SyntheticsMonitorTypes.BROWSER):
 		simpleBrowserMonitorInput := buildSyntheticsSimpleBrowserMonitor(d)
+		simpleBrowserMonitorInput.Locations.Private = expandSyntheticsPrivateLocationGUIDs(simpleBrowserMonitorInput.Locations.Private, accountID)
 		resp, err = client.Synthetics.SyntheticsCreateSimpleBrowserMonitorWithContext(ctx, accountID, simpleBrowserMonitorInput)
 	}
 
@@ -261,7 +270,7 @@ func resourceNewRelicSyntheticsMonitorRead(ctx context.Context, d *schema.Resour
 	}
 
 	// This should probably be in go-client so we can use *errors.NotFound
-	if *resp == nil {
+	if isSyntheticsMonitorEntityDeleted(resp) {
 		d.SetId("")
 		return nil
 	}
@@ -285,6 +294,7 @@ func setCommonSyntheticsMonitorAttributes(v *entities.EntityInterface, d *schema
 		})
 
 		_ = d.Set("period_in_minutes", e.GetPeriod())
+		_ = d.Set("locations_public", getPublicLocationsFromEntityTags(e.Tags))
 
 		if err != nil {
 			diag.FromErr(err)
@@ -318,7 +328,9 @@ func setCommonSyntheticsMonitorAttributes(v *entities.EntityInterface, d *schema
 }
 
 func resourceNewRelicSyntheticsMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
 
 	log.Printf("[INFO] Updating New Relic Synthetics monitor %s", d.Id())
 
@@ -334,6 +346,7 @@ func resourceNewRelicSyntheticsMonitorUpdate(ctx context.Context, d *schema.Reso
 	switch monitorType.(string) {
 	case string(SyntheticsMonitorTypes.SIMPLE):
 		simpleMonitorUpdateInput := buildSyntheticsSimpleMonitorUpdateStruct(d)
+		simpleMonitorUpdateInput.Locations.Private = expandSyntheticsPrivateLocationGUIDs(simpleMonitorUpdateInput.Locations.Private, accountID)
 		resp, err := client.Synthetics.SyntheticsUpdateSimpleMonitorWithContext(ctx, guid, simpleMonitorUpdateInput)
 		if err != nil {
 			return diag.FromErr(err)
@@ -351,6 +364,7 @@ func resourceNewRelicSyntheticsMonitorUpdate(ctx context.Context, d *schema.Reso
 
 	case string(SyntheticsMonitorTypes.BROWSER):
 		simpleBrowserMonitorUpdateInput := buildSyntheticsSimpleBrowserMonitorUpdateStruct(d)
+		simpleBrowserMonitorUpdateInput.Locations.Private = expandSyntheticsPrivateLocationGUIDs(simpleBrowserMonitorUpdateInput.Locations.Private, accountID)
 		resp, err := client.Synthetics.SyntheticsUpdateSimpleBrowserMonitorWithContext(ctx, guid, simpleBrowserMonitorUpdateInput)
 		if err != nil {
 			return diag.FromErr(err)
@@ -369,6 +383,11 @@ func resourceNewRelicSyntheticsMonitorUpdate(ctx context.Context, d *schema.Reso
 	if len(diags) > 0 {
 		return diags
 	}
+
+	if err := updateSyntheticsMonitorTags(ctx, &client.Entities, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -418,9 +437,11 @@ func resourceNewRelicSyntheticsMonitorDelete(ctx context.Context, d *schema.Reso
 
 	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
 
-	if _, err := client.Synthetics.SyntheticsDeleteMonitorWithContext(ctx, guid); err != nil {
+	if err := deleteSyntheticsMonitor(ctx, &client.Synthetics, guid); err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId("")
+
 	return nil
 }
@@ -0,0 +1,84 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceNewRelicSyntheticsMonitorUpdate_PeriodAndStatus(t *testing.T) {
+	t.Parallel()
+
+	var updates int32
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Monitor map[string]interface{} `json:"monitor"`
+			} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.True(t, strings.Contains(body.Query, "syntheticsUpdateSimpleMonitor"), body.Query)
+
+		atomic.AddInt32(&updates, 1)
+		assert.Equal(t, "EVERY_HOUR", body.Variables.Monitor["period"])
+		assert.Equal(t, "DISABLED", body.Variables.Monitor["status"])
+
+		_, _ = w.Write([]byte(`{"data":{"syntheticsUpdateSimpleMonitor":{"errors":[],"monitor":{
+			"guid":"MXxTWU5USHxNT05JVE9SfDE","name":"ping","uri":"https://example.com","period":"EVERY_HOUR","status":"DISABLED",
+			"locations":{"public":["US_WEST_1"],"private":[]},"advancedOptions":{}
+		}}}}`))
+	})
+
+	r := resourceNewRelicSyntheticsMonitor()
+	state := &terraform.InstanceState{
+		ID: "MXxTWU5USHxNT05JVE9SfDE",
+		Attributes: map[string]string{
+			"id":                 "MXxTWU5USHxNT05JVE9SfDE",
+			"account_id":         "1",
+			"type":               "SIMPLE",
+			"name":               "ping",
+			"uri":                "https://example.com",
+			"period":             "EVERY_MINUTE",
+			"status":             "ENABLED",
+			"locations_public.#": "1",
+			"locations_public.0": "US_WEST_1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"type":             "SIMPLE",
+		"name":             "ping",
+		"uri":              "https://example.com",
+		"period":           "EVERY_HOUR",
+		"status":           "DISABLED",
+		"locations_public": []interface{}{"US_WEST_1"},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+	require.NoError(t, err)
+	require.False(t, diff.RequiresNew())
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.NoError(t, err)
+	require.True(t, d.HasChange("period"))
+	require.True(t, d.HasChange("status"))
+
+	// Both changes are sent by a single update mutation
+	diags := resourceNewRelicSyntheticsMonitorUpdate(context.Background(), d, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, int32(1), atomic.LoadInt32(&updates))
+
+	require.Equal(t, "EVERY_HOUR", d.Get("period"))
+	require.Equal(t, "DISABLED", d.Get("status"))
+}
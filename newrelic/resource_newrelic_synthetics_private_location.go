@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
@@ -20,8 +24,9 @@ func resourceNewRelicSyntheticsPrivateLocation() *schema.Resource {
 		UpdateContext: resourceNewRelicSyntheticsPrivateLocationUpdate,
 		DeleteContext: resourceNewRelicSyntheticsPrivateLocationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNewRelicSyntheticsPrivateLocationImport,
 		},
+		CustomizeDiff: resourceNewRelicSyntheticsPrivateLocationCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -46,6 +51,18 @@ func resourceNewRelicSyntheticsPrivateLocation() *schema.Resource {
 				Default:     false,
 				Description: "The private location requires a password to edit if value is true.",
 			},
+			"tag": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of key-value pairs to tag the private location with. Only the configured tag keys are managed.",
+				Elem:        entityTagSchemaElem(),
+			},
+			"tags_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The tags of the private location, including the default tags of the provider.",
+				Elem:        entityTagSchemaElem(),
+			},
 			"domain_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -67,6 +84,9 @@ func resourceNewRelicSyntheticsPrivateLocation() *schema.Resource {
 				Description: "An alternate identifier based on name.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
 	}
 }
 
@@ -81,7 +101,7 @@ func resourceNewRelicSyntheticsPrivateLocationCreate(ctx context.Context, d *sch
 	verifiedScriptExecution := d.Get("verified_script_execution").(bool)
 	res, err := client.Synthetics.SyntheticsCreatePrivateLocationWithContext(ctx, accountID, description, name, verifiedScriptExecution)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromGraphQLErr(err)
 	}
 
 	if len(res.Errors) > 0 {
@@ -99,6 +119,16 @@ func resourceNewRelicSyntheticsPrivateLocationCreate(ctx context.Context, d *sch
 
 	d.SetId(string(res.GUID))
 
+	if err := waitForSyntheticsPrivateLocationEntity(ctx, client, common.EntityGUID(res.GUID), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tags := d.Get("tags_all").(*schema.Set).List(); len(tags) > 0 {
+		if err := applyEntityTags(ctx, client, "newrelic_synthetics_private_location", common.EntityGUID(res.GUID), nil, tags); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_ = d.Set("domain_id", res.DomainId)
 	_ = d.Set("key", res.Key)
 	_ = d.Set("location_id", res.LocationId)
@@ -107,8 +137,58 @@ func resourceNewRelicSyntheticsPrivateLocationCreate(ctx context.Context, d *sch
 	return resourceNewRelicSyntheticsPrivateLocationRead(ctx, d, meta)
 }
 
+// New private locations take a while to be indexed as entities, so polls the entity, with an
+// exponential backoff, until it can be read or the timeout elapses.
+func waitForSyntheticsPrivateLocationEntity(ctx context.Context, client *newrelic.NewRelic, guid common.EntityGUID, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		entity, err := client.Entities.GetEntityWithContext(ctx, guid)
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				return resource.RetryableError(fmt.Errorf("private location %s is not queryable yet", guid))
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if entity == nil || *entity == nil {
+			return resource.RetryableError(fmt.Errorf("private location %s is not queryable yet", guid))
+		}
+
+		return nil
+	})
+}
+
+// Imports a private location by its GUID, or by its domain ID, optionally prefixed with the account
+// ID as `<account_id>:<domain_id>`. The GUID is built from the domain ID, and the read that follows
+// the import fails if no such private location exists.
+func resourceNewRelicSyntheticsPrivateLocationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, ok := parseSyntheticsPrivateLocationGUID(d.Id()); ok {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if _, err := resourceImportStateWithAccountID()(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	accountID := d.Get("account_id").(int)
+	if accountID == 0 {
+		return nil, fmt.Errorf("an account ID is required to import a private location by domain ID, use <account_id>:<domain_id>")
+	}
+
+	domainID := d.Id()
+	guid := syntheticsPrivateLocationGUID(accountID, domainID)
+
+	log.Printf("[INFO] Importing New Relic Synthetics Private Location %s by domain ID %s", guid, domainID)
+
+	d.SetId(guid)
+	_ = d.Set("domain_id", domainID)
+	_ = d.Set("guid", guid)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNewRelicSyntheticsPrivateLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
 	log.Printf("[INFO] Reading New Relic Synthetics Private Location %s", d.Id())
 
 	guid := common.EntityGUID(d.Id())
@@ -125,49 +205,93 @@ func resourceNewRelicSyntheticsPrivateLocationRead(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	if resp == nil {
+	if resp == nil || *resp == nil {
 		d.SetId("")
 		return nil
 	}
 
 	setCommonSyntheticsPrivateLocationAttributes(resp, d)
 
-	return nil
+	tags, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, guid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only the configured tag keys and the provider's default tags are managed, the private location
+	// can have other tags
+	allTags := flattenEntityTagList(convertTagTypes(tags))
+	if err := d.Set("tags_all", configuredEntityTags(allTags, d.Get("tags_all").(*schema.Set).List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("tag", configuredEntityTags(allTags, d.Get("tag").(*schema.Set).List())))
+}
+
+// resourceNewRelicSyntheticsPrivateLocationCustomizeDiff plans the tags of the private location, the
+// configured tags merged with the default tags of the provider.
+func resourceNewRelicSyntheticsPrivateLocationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tag") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	providerConfig, _ := meta.(*ProviderConfig)
+
+	return diff.SetNew("tags_all", mergeDefaultTags(providerConfig, diff.Get("tag").(*schema.Set).List()))
 }
 
+// setCommonSyntheticsPrivateLocationAttributes sets the attributes read from the live entity. The name
+// is always refreshed, so a location renamed outside of Terraform is planned for replacement.
 func setCommonSyntheticsPrivateLocationAttributes(v *entities.EntityInterface, d *schema.ResourceData) {
-	switch e := (*v).(type) {
-	case *entities.GenericEntity:
-		_ = d.Set("account_id", e.AccountID)
-		_ = d.Set("guid", string(e.GUID))
-		_ = d.Set("name", e.Name)
+	e := *v
+	if e == nil {
+		return
+	}
+
+	_ = d.Set("account_id", e.GetAccountID())
+	_ = d.Set("guid", string(e.GetGUID()))
+	_ = d.Set("name", e.GetName())
+
+	if domainID, ok := parseSyntheticsPrivateLocationGUID(string(e.GetGUID())); ok {
+		_ = d.Set("domain_id", domainID)
 	}
 }
 
 func resourceNewRelicSyntheticsPrivateLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
-	var diags diag.Diagnostics
 
+	diags := updateSyntheticsPrivateLocation(ctx, &client.Synthetics, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := applyEntityTags(ctx, client, "newrelic_synthetics_private_location", common.EntityGUID(d.Id()), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, resourceNewRelicSyntheticsPrivateLocationRead(ctx, d, meta)...)
+}
+
+// syntheticsPrivateLocationUpdater is the subset of the synthetics client used to update a private location.
+type syntheticsPrivateLocationUpdater interface {
+	SyntheticsUpdatePrivateLocationWithContext(context.Context, string, synthetics.EntityGUID, bool) (*synthetics.SyntheticsPrivateLocationMutationResult, error)
+}
+
+func updateSyntheticsPrivateLocation(ctx context.Context, client syntheticsPrivateLocationUpdater, d *schema.ResourceData) diag.Diagnostics {
 	description := d.Get("description").(string)
 	guid := synthetics.EntityGUID(d.Id())
 	verifiedScriptExecution := d.Get("verified_script_execution").(bool)
 
-	res, err := client.Synthetics.SyntheticsUpdatePrivateLocationWithContext(ctx, description, guid, verifiedScriptExecution)
+	res, err := client.SyntheticsUpdatePrivateLocationWithContext(ctx, description, guid, verifiedScriptExecution)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if len(res.Errors) > 0 {
-		for _, err := range res.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Description,
-			})
-		}
+		return diagFromGraphQLErr(err)
 	}
 
-	if len(diags) > 0 {
+	diags := buildSyntheticsPrivateLocationMutationDiagnostics(res.Errors)
+	if diags.HasError() {
 		return diags
 	}
 
@@ -176,7 +300,32 @@ func resourceNewRelicSyntheticsPrivateLocationUpdate(ctx context.Context, d *sch
 	_ = d.Set("location_id", res.LocationId)
 	_ = d.Set("guid", string(res.GUID))
 
-	return resourceNewRelicSyntheticsPrivateLocationRead(ctx, d, meta)
+	return diags
+}
+
+// buildSyntheticsPrivateLocationMutationDiagnostics reports the documented error types of a private
+// location mutation as errors, and any other entry returned alongside the result as a warning.
+func buildSyntheticsPrivateLocationMutationDiagnostics(errs []synthetics.SyntheticsPrivateLocationMutationError) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, err := range errs {
+		severity := diag.Warning
+		switch err.Type {
+		case synthetics.SyntheticsPrivateLocationMutationErrorTypeTypes.BAD_REQUEST,
+			synthetics.SyntheticsPrivateLocationMutationErrorTypeTypes.INTERNAL_SERVER_ERROR,
+			synthetics.SyntheticsPrivateLocationMutationErrorTypeTypes.NOT_FOUND,
+			synthetics.SyntheticsPrivateLocationMutationErrorTypeTypes.UNAUTHORIZED:
+			severity = diag.Error
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  err.Description,
+			Detail:   string(err.Type),
+		})
+	}
+
+	return diags
 }
 
 func resourceNewRelicSyntheticsPrivateLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -185,10 +334,19 @@ func resourceNewRelicSyntheticsPrivateLocationDelete(ctx context.Context, d *sch
 	var diags diag.Diagnostics
 	guid := synthetics.EntityGUID(d.Id())
 
+	// Monitors left running in a deleted private location are broken, so warn about them. Failing
+	// to look them up must not block the deletion.
+	monitorGUIDs, err := findSyntheticsMonitorsInPrivateLocation(ctx, client, string(guid))
+	if err != nil {
+		log.Printf("[WARN] Unable to look up monitors in private location %s: %s", guid, err)
+	} else if len(monitorGUIDs) > 0 {
+		diags = append(diags, buildSyntheticsPrivateLocationInUseDiagnostic(string(guid), monitorGUIDs))
+	}
+
 	res, err := client.Synthetics.SyntheticsDeletePrivateLocationWithContext(ctx, guid)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diagFromGraphQLErr(err)...)
 	}
 	if res != nil {
 		for _, err := range res.Errors {
@@ -199,10 +357,40 @@ func resourceNewRelicSyntheticsPrivateLocationDelete(ctx context.Context, d *sch
 		}
 	}
 
-	if len(diags) > 0 {
+	if diags.HasError() {
 		return diags
 	}
 
 	d.SetId("")
-	return nil
+	return diags
+}
+
+// Returns the GUIDs of the synthetic monitors that run in the given private location, found
+// through the `privateLocation` tag New Relic adds to monitor entities.
+func findSyntheticsMonitorsInPrivateLocation(ctx context.Context, client *newrelic.NewRelic, guid string) ([]string, error) {
+	query := fmt.Sprintf("domain = 'SYNTH' AND type = 'MONITOR' AND tags.privateLocation = '%s'", guid)
+
+	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(ctx, entities.EntitySearchOptions{}, query, []entities.EntitySearchSortCriteria{})
+	if err != nil {
+		return nil, err
+	}
+
+	if entitySearch == nil {
+		return nil, fmt.Errorf("GetEntitySearchByQuery response was nil")
+	}
+
+	guids := []string{}
+	for _, e := range entitySearch.Results.Entities {
+		guids = append(guids, string(e.GetGUID()))
+	}
+
+	return guids, nil
+}
+
+func buildSyntheticsPrivateLocationInUseDiagnostic(guid string, monitorGUIDs []string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("private location %s is still used by %d monitor(s)", guid, len(monitorGUIDs)),
+		Detail:   fmt.Sprintf("The following monitors will no longer run in this location once it is deleted: %s", strings.Join(monitorGUIDs, ", ")),
+	}
 }
@@ -4,9 +4,13 @@
 package newrelic
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
@@ -40,12 +44,313 @@ func TestAccNewRelicSyntheticsPrivateLocation_Basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"description", "domain_id", "key", "location_id", "verified_script_execution"},
+				ImportStateVerifyIgnore: []string{"description", "key", "location_id", "verified_script_execution"},
 			},
 		},
 	})
 }
 
+func TestAccNewRelicSyntheticsPrivateLocation_ImportByDomainID(t *testing.T) {
+	resourceName := "newrelic_synthetics_private_location.bar"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+				),
+			},
+			// Test: Import by domain ID
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccNewRelicSyntheticsPrivateLocationDomainID(resourceName),
+				ImportStateVerifyIgnore: []string{"description", "key", "location_id", "verified_script_execution"},
+			},
+		},
+	})
+}
+
+func testAccNewRelicSyntheticsPrivateLocationDomainID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["domain_id"], nil
+	}
+}
+
+func TestAccNewRelicSyntheticsPrivateLocation_Tags(t *testing.T) {
+	resourceName := "newrelic_synthetics_private_location.bar"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create with tags
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigTags(rName, `
+	tag {
+		key    = "team"
+		values = ["synthetics"]
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+				),
+			},
+			// Test: Add a tag
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigTags(rName, `
+	tag {
+		key    = "team"
+		values = ["synthetics"]
+	}
+
+	tag {
+		key    = "environment"
+		values = ["staging", "production"]
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "2"),
+				),
+			},
+			// Test: Remove a tag
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigTags(rName, `
+	tag {
+		key    = "environment"
+		values = ["production"]
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+				),
+			},
+			// Test: Remove every tag
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigTags(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsPrivateLocation_DefaultTags(t *testing.T) {
+	resourceName := "newrelic_synthetics_private_location.bar"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create with default tags
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigDefaultTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationTagged(resourceName, "managed_by", "terraform"),
+					testAccCheckNewRelicSyntheticsPrivateLocationTagged(resourceName, "team", "synthetics"),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "2"),
+				),
+			},
+			// Test: Default tags don't cause changes
+			{
+				Config:   testAccNewRelicSyntheticsPrivateLocationConfigDefaultTags(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsPrivateLocation_VerifiedScriptExecution(t *testing.T) {
+	resourceName := "newrelic_synthetics_private_location.bar"
+	rName := generateNameForIntegrationTestResource()
+	var guid string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigVerifiedScriptExecution(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationSameGUID(resourceName, &guid),
+					resource.TestCheckResourceAttr(resourceName, "verified_script_execution", "false"),
+				),
+			},
+			// Test: Enable verified script execution in-place
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigVerifiedScriptExecution(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationSameGUID(resourceName, &guid),
+					resource.TestCheckResourceAttr(resourceName, "verified_script_execution", "true"),
+				),
+			},
+			// Test: Disable verified script execution in-place
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigVerifiedScriptExecution(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationSameGUID(resourceName, &guid),
+					resource.TestCheckResourceAttr(resourceName, "verified_script_execution", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsPrivateLocation_Rename(t *testing.T) {
+	resourceName := "newrelic_synthetics_private_location.bar"
+	rName := generateNameForIntegrationTestResource()
+	var guid string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationSameGUID(resourceName, &guid),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			// Test: A name that differs from the name read plans a replacement
+			{
+				Config:             testAccNewRelicSyntheticsPrivateLocationConfig(rName + "-renamed"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test: Rename
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfig(rName + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationReplaced(resourceName, &guid),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsPrivateLocation_PresentAfterCreate(t *testing.T) {
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Every location is queryable as soon as it is created
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigCount(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists("newrelic_synthetics_private_location.bar.0"),
+					testAccCheckNewRelicSyntheticsPrivateLocationExists("newrelic_synthetics_private_location.bar.1"),
+					testAccCheckNewRelicSyntheticsPrivateLocationExists("newrelic_synthetics_private_location.bar.2"),
+					resource.TestCheckResourceAttrSet("newrelic_synthetics_private_location.bar.0", "guid"),
+					resource.TestCheckResourceAttrSet("newrelic_synthetics_private_location.bar.1", "guid"),
+					resource.TestCheckResourceAttrSet("newrelic_synthetics_private_location.bar.2", "guid"),
+				),
+			},
+			// Test: The locations are still in state on the next plan
+			{
+				Config:   testAccNewRelicSyntheticsPrivateLocationConfigCount(rName, 3),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsPrivateLocation_InUseWarning(t *testing.T) {
+	resourceName := "newrelic_synthetics_private_location.bar"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsPrivateLocationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create a monitor in the private location
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationConfigWithMonitor(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsPrivateLocationExists(resourceName),
+					testAccCheckNewRelicSyntheticsPrivateLocationInUseWarning(resourceName, "newrelic_synthetics_monitor.foo"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies deleting the private location would warn about the monitor running in it.
+func testAccCheckNewRelicSyntheticsPrivateLocationInUseWarning(n string, monitor string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		ms, ok := s.RootModule().Resources[monitor]
+		if !ok {
+			return fmt.Errorf("not found: %s", monitor)
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+		// Monitor tags are indexed asynchronously.
+		var monitorGUIDs []string
+		for i := 0; i < 10; i++ {
+			var err error
+			monitorGUIDs, err = findSyntheticsMonitorsInPrivateLocation(context.Background(), client, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if len(monitorGUIDs) > 0 {
+				break
+			}
+
+			time.Sleep(10 * time.Second)
+		}
+
+		warning := buildSyntheticsPrivateLocationInUseDiagnostic(rs.Primary.ID, monitorGUIDs)
+		if warning.Severity != diag.Warning || !strings.Contains(warning.Detail, ms.Primary.ID) {
+			return fmt.Errorf("expected a warning listing monitor %s, got %q", ms.Primary.ID, warning.Detail)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNewRelicSyntheticsPrivateLocationExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -71,6 +376,65 @@ func testAccCheckNewRelicSyntheticsPrivateLocationExists(n string) resource.Test
 	}
 }
 
+func testAccCheckNewRelicSyntheticsPrivateLocationTagged(n string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+		tags, err := client.Entities.GetTagsForEntityMutable(common.EntityGUID(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+
+		tag := getTag(convertTagTypes(tags), key)
+		if tag == nil || !tagValuesExist(tag, []string{value}) {
+			return fmt.Errorf("expected private location %s to be tagged with %s = %s", rs.Primary.ID, key, value)
+		}
+
+		return nil
+	}
+}
+
+// Stores the private location GUID on the first call and verifies it is unchanged on subsequent calls.
+func testAccCheckNewRelicSyntheticsPrivateLocationSameGUID(n string, guid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if *guid == "" {
+			*guid = rs.Primary.ID
+			return nil
+		}
+
+		if rs.Primary.ID != *guid {
+			return fmt.Errorf("expected private location to be updated in-place, but GUID changed from %s to %s", *guid, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNewRelicSyntheticsPrivateLocationReplaced(n string, guid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == *guid {
+			return fmt.Errorf("expected private location to be replaced, but GUID is still %s", *guid)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNewRelicSyntheticsPrivateLocationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ProviderConfig).NewClient
 
@@ -111,3 +475,78 @@ func testAccNewRelicSyntheticsPrivateLocationConfigUpdated(name string) string {
 }
 `, name)
 }
+
+func testAccNewRelicSyntheticsPrivateLocationConfigVerifiedScriptExecution(name string, verifiedScriptExecution bool) string {
+	return fmt.Sprintf(`
+	resource "newrelic_synthetics_private_location" "bar" {
+		description               = "Test Description"
+		name                      = "%[1]s"
+		verified_script_execution = %[2]t
+}
+`, name, verifiedScriptExecution)
+}
+
+func testAccNewRelicSyntheticsPrivateLocationConfigTags(name string, tags string) string {
+	return fmt.Sprintf(`
+	resource "newrelic_synthetics_private_location" "bar" {
+		description               = "Test Description"
+		name                      = "%[1]s"
+		verified_script_execution = false
+%[2]s
+}
+`, name, tags)
+}
+
+func testAccNewRelicSyntheticsPrivateLocationConfigDefaultTags(name string) string {
+	return fmt.Sprintf(`
+provider "newrelic" {
+	default_tags {
+		tags = {
+			managed_by = "terraform"
+			team       = "platform"
+		}
+	}
+}
+
+resource "newrelic_synthetics_private_location" "bar" {
+	description               = "Test Description"
+	name                      = "%[1]s"
+	verified_script_execution = false
+
+	tag {
+		key    = "team"
+		values = ["synthetics"]
+	}
+}
+`, name)
+}
+
+func testAccNewRelicSyntheticsPrivateLocationConfigWithMonitor(name string) string {
+	return fmt.Sprintf(`
+	resource "newrelic_synthetics_private_location" "bar" {
+		description               = "Test Description"
+		name                      = "%[1]s"
+		verified_script_execution = false
+}
+
+	resource "newrelic_synthetics_monitor" "foo" {
+		name              = "%[1]s"
+		type              = "SIMPLE"
+		period            = "EVERY_15_MINUTES"
+		status            = "DISABLED"
+		uri               = "https://www.one.newrelic.com"
+		locations_private = [newrelic_synthetics_private_location.bar.id]
+}
+`, name)
+}
+
+func testAccNewRelicSyntheticsPrivateLocationConfigCount(name string, count int) string {
+	return fmt.Sprintf(`
+	resource "newrelic_synthetics_private_location" "bar" {
+		count                     = %[2]d
+		description               = "Test Description"
+		name                      = "%[1]s-${count.index}"
+		verified_script_execution = false
+}
+`, name, count)
+}
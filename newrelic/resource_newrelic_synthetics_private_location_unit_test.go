@@ -0,0 +1,384 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/synthetics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsPrivateLocation_VerifiedScriptExecutionUpdatesInPlace(t *testing.T) {
+	r := resourceNewRelicSyntheticsPrivateLocation()
+	require.False(t, r.Schema["verified_script_execution"].ForceNew)
+
+	state := &terraform.InstanceState{
+		ID: "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ",
+		Attributes: map[string]string{
+			"id":                        "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ",
+			"account_id":                "1",
+			"description":               "description",
+			"name":                      "private-location",
+			"verified_script_execution": "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description":               "description",
+		"name":                      "private-location",
+		"verified_script_execution": true,
+	})
+
+	diff, err := r.SimpleDiff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	require.Equal(t, "true", diff.Attributes["verified_script_execution"].New)
+}
+
+func TestSyntheticsPrivateLocation_DefaultTags(t *testing.T) {
+	r := resourceNewRelicSyntheticsPrivateLocation()
+	meta := &ProviderConfig{DefaultTags: map[string]string{"managed_by": "terraform", "team": "platform"}}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description": "description",
+		"name":        "private-location",
+		"tag": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"synthetics"}},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+	require.NoError(t, err)
+
+	d, err := schema.InternalMap(r.Schema).Data(nil, diff)
+	require.NoError(t, err)
+	require.Equal(t, 1, d.Get("tag").(*schema.Set).Len())
+
+	tags := expandEntityTags(d.Get("tags_all").(*schema.Set).List())
+	require.ElementsMatch(t, []string{"managed_by", "team"}, getTagKeys(tags))
+	for _, tag := range tags {
+		if tag.Key == "team" {
+			require.Equal(t, []string{"synthetics"}, tag.Values)
+		}
+	}
+
+	// Once applied, the default tags don't cause further changes
+	d.SetId("MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ")
+	diff, err = r.Diff(context.Background(), d.State(), config, meta)
+	require.NoError(t, err)
+	require.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// Changing the default tags updates the private location in place
+	meta.DefaultTags["team"] = "observability"
+	meta.DefaultTags["cost_center"] = "1234"
+	diff, err = r.Diff(context.Background(), d.State(), config, meta)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	require.Equal(t, "3", diff.Attributes["tags_all.#"].New)
+}
+
+type testSyntheticsPrivateLocationUpdater struct {
+	result *synthetics.SyntheticsPrivateLocationMutationResult
+}
+
+func (u *testSyntheticsPrivateLocationUpdater) SyntheticsUpdatePrivateLocationWithContext(_ context.Context, _ string, _ synthetics.EntityGUID, _ bool) (*synthetics.SyntheticsPrivateLocationMutationResult, error) {
+	return u.result, nil
+}
+
+func testSyntheticsPrivateLocationUpdateData(t *testing.T) *schema.ResourceData {
+	d := resourceNewRelicSyntheticsPrivateLocation().TestResourceData()
+	d.SetId("MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ")
+	require.NoError(t, d.Set("description", "description"))
+
+	return d
+}
+
+func TestUpdateSyntheticsPrivateLocation_WarningDoesNotFail(t *testing.T) {
+	d := testSyntheticsPrivateLocationUpdateData(t)
+	client := &testSyntheticsPrivateLocationUpdater{
+		result: &synthetics.SyntheticsPrivateLocationMutationResult{
+			GUID:       "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ",
+			Key:        "key",
+			LocationId: "1-private-location",
+			Errors: []synthetics.SyntheticsPrivateLocationMutationError{
+				{Type: "WARNING", Description: "verified script execution takes effect on the next minion restart"},
+			},
+		},
+	}
+
+	diags := updateSyntheticsPrivateLocation(context.Background(), client, d)
+
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "verified script execution takes effect on the next minion restart", diags[0].Summary)
+	require.Equal(t, "key", d.Get("key"))
+	require.Equal(t, "1-private-location", d.Get("location_id"))
+}
+
+func TestUpdateSyntheticsPrivateLocation_ErrorFails(t *testing.T) {
+	d := testSyntheticsPrivateLocationUpdateData(t)
+	client := &testSyntheticsPrivateLocationUpdater{
+		result: &synthetics.SyntheticsPrivateLocationMutationResult{
+			Errors: []synthetics.SyntheticsPrivateLocationMutationError{
+				{Type: "WARNING", Description: "warning"},
+				{Type: synthetics.SyntheticsPrivateLocationMutationErrorTypeTypes.BAD_REQUEST, Description: "invalid description"},
+			},
+		},
+	}
+
+	diags := updateSyntheticsPrivateLocation(context.Background(), client, d)
+
+	require.True(t, diags.HasError())
+	require.Len(t, diags, 2)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, diag.Error, diags[1].Severity)
+	require.Equal(t, "BAD_REQUEST", diags[1].Detail)
+	require.Empty(t, d.Get("key"))
+}
+
+func TestResourceNewRelicSyntheticsPrivateLocationCreate_GraphQLErrors(t *testing.T) {
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid name","path":["syntheticsCreatePrivateLocation"],"extensions":{"errorClass":"VALIDATION_ERROR"}}]}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsPrivateLocation().Schema, map[string]interface{}{
+		"description": "description",
+		"name":        "private-location",
+	})
+
+	diags := resourceNewRelicSyntheticsPrivateLocationCreate(context.Background(), d, meta)
+	require.Len(t, diags, 1)
+	require.Equal(t, "Invalid name", diags[0].Summary)
+	require.Equal(t, "path: syntheticsCreatePrivateLocation\nerrorClass: VALIDATION_ERROR", diags[0].Detail)
+	require.Empty(t, d.Id())
+}
+
+func TestResourceNewRelicSyntheticsPrivateLocationImport(t *testing.T) {
+	domainID := "9bd8c7f4-2c5e-4a57-9d6f-3a2b1c0d9e8f"
+
+	cases := map[string]struct {
+		id        string
+		accountID int
+	}{
+		"guid":                   {syntheticsPrivateLocationGUID(2, domainID), 2},
+		"domain id":              {domainID, 1},
+		"account and domain ids": {"2:" + domainID, 2},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := resourceNewRelicSyntheticsPrivateLocation().TestResourceData()
+			d.SetId(tc.id)
+
+			_, err := resourceNewRelicSyntheticsPrivateLocationImport(context.Background(), d, &ProviderConfig{AccountID: 1})
+			require.NoError(t, err)
+			require.Equal(t, syntheticsPrivateLocationGUID(tc.accountID, domainID), d.Id())
+		})
+	}
+
+	d := resourceNewRelicSyntheticsPrivateLocation().TestResourceData()
+	d.SetId("2:" + domainID)
+
+	_, err := resourceNewRelicSyntheticsPrivateLocationImport(context.Background(), d, &ProviderConfig{AccountID: 1})
+	require.NoError(t, err)
+	require.Equal(t, domainID, d.Get("domain_id"))
+	require.Equal(t, d.Id(), d.Get("guid"))
+	require.Equal(t, 2, d.Get("account_id"))
+
+	d = resourceNewRelicSyntheticsPrivateLocation().TestResourceData()
+	d.SetId(domainID)
+
+	_, err = resourceNewRelicSyntheticsPrivateLocationImport(context.Background(), d, &ProviderConfig{})
+	require.Error(t, err)
+}
+
+func TestResourceNewRelicSyntheticsPrivateLocationRead_OnlyConfiguredTagKeys(t *testing.T) {
+	t.Parallel()
+
+	// The `owner` tag is managed outside of the resource, e.g. by newrelic_entity_tags
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"data":{"actor":{"entity":{
+			"__typename":"GenericEntity","accountId":1,"guid":"MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ","name":"private-location",
+			"tagsWithMetadata":[
+				{"key":"team","values":[{"mutable":true,"value":"synthetics"}]},
+				{"key":"managed_by","values":[{"mutable":true,"value":"terraform"}]},
+				{"key":"owner","values":[{"mutable":true,"value":"jdoe"}]}
+			]
+		}}}}`)
+	})
+	meta.DefaultTags = map[string]string{"managed_by": "terraform"}
+
+	r := resourceNewRelicSyntheticsPrivateLocation()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"description": "description",
+		"name":        "private-location",
+		"tag":         []interface{}{map[string]interface{}{"key": "team", "values": []interface{}{"synthetics"}}},
+		"tags_all": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"synthetics"}},
+			map[string]interface{}{"key": "managed_by", "values": []interface{}{"terraform"}},
+		},
+	})
+	d.SetId("MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ")
+
+	diags := resourceNewRelicSyntheticsPrivateLocationRead(context.Background(), d, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.ElementsMatch(t, []string{"team"}, getTagKeys(expandEntityTags(d.Get("tag").(*schema.Set).List())))
+	require.ElementsMatch(t, []string{"team", "managed_by"}, getTagKeys(expandEntityTags(d.Get("tags_all").(*schema.Set).List())))
+}
+
+func TestResourceNewRelicSyntheticsPrivateLocationRead_RenamedOutsideTerraform(t *testing.T) {
+	t.Parallel()
+
+	// The location was renamed in the UI since it was last read
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"data":{"actor":{"entity":{
+			"__typename":"GenericEntity","accountId":1,"guid":"MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ","name":"renamed-in-ui",
+			"tagsWithMetadata":[]
+		}}}}`)
+	})
+
+	r := resourceNewRelicSyntheticsPrivateLocation()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"description": "description",
+		"name":        "private-location",
+	})
+	d.SetId("MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ")
+
+	diags := resourceNewRelicSyntheticsPrivateLocationRead(context.Background(), d, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "renamed-in-ui", d.Get("name"))
+
+	// Planning the configured name against the name read replaces the location
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description": "description",
+		"name":        "private-location",
+	}), meta)
+	require.NoError(t, err)
+	require.True(t, diff.RequiresNew())
+	require.Equal(t, "renamed-in-ui", diff.Attributes["name"].Old)
+	require.Equal(t, "private-location", diff.Attributes["name"].New)
+}
+
+// testMockSyntheticsPrivateLocationDeleteProviderConfig answers the monitor lookup with the given
+// monitor entities and the delete mutation with success.
+func testMockSyntheticsPrivateLocationDeleteProviderConfig(t *testing.T, monitors string) *ProviderConfig {
+	return testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Query string `json:"query"`
+			} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		if strings.Contains(body.Query, "syntheticsDeletePrivateLocation") {
+			_, _ = fmt.Fprint(w, `{"data":{"syntheticsDeletePrivateLocation":{"errors":[]}}}`)
+			return
+		}
+
+		assert.Equal(t, "domain = 'SYNTH' AND type = 'MONITOR' AND tags.privateLocation = 'MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ'", body.Variables.Query)
+		_, _ = fmt.Fprintf(w, `{"data":{"actor":{"entitySearch":{"results":{"entities":[%s]}}}}}`, monitors)
+	})
+}
+
+func testResourceNewRelicSyntheticsPrivateLocationDelete(t *testing.T, meta *ProviderConfig) diag.Diagnostics {
+	d := resourceNewRelicSyntheticsPrivateLocation().TestResourceData()
+	d.SetId("MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ")
+
+	diags := resourceNewRelicSyntheticsPrivateLocationDelete(context.Background(), d, meta)
+	require.Empty(t, d.Id())
+
+	return diags
+}
+
+func TestResourceNewRelicSyntheticsPrivateLocationDelete_WarnsAboutMonitors(t *testing.T) {
+	t.Parallel()
+
+	meta := testMockSyntheticsPrivateLocationDeleteProviderConfig(t, `
+		{"__typename": "SyntheticMonitorEntityOutline", "accountId": 1, "guid": "MXxTWU5USHxNT05JVE9SfDE", "name": "first"},
+		{"__typename": "SyntheticMonitorEntityOutline", "accountId": 1, "guid": "MXxTWU5USHxNT05JVE9SfDI", "name": "second"}`)
+
+	diags := testResourceNewRelicSyntheticsPrivateLocationDelete(t, meta)
+
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "private location MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ is still used by 2 monitor(s)", diags[0].Summary)
+	require.Equal(t, "The following monitors will no longer run in this location once it is deleted: MXxTWU5USHxNT05JVE9SfDE, MXxTWU5USHxNT05JVE9SfDI", diags[0].Detail)
+}
+
+func TestResourceNewRelicSyntheticsPrivateLocationDelete_Unused(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, testResourceNewRelicSyntheticsPrivateLocationDelete(t, testMockSyntheticsPrivateLocationDeleteProviderConfig(t, "")))
+}
+
+// testMockSyntheticsPrivateLocationEntityClient answers the entity query with no entity until it
+// has been polled the given number of times.
+func testMockSyntheticsPrivateLocationEntityClient(t *testing.T, pollsUntilFound int32) (*newrelic.NewRelic, *int32) {
+	var polls int32
+
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&polls, 1) <= pollsUntilFound {
+			_, _ = fmt.Fprint(w, `{"data":{"actor":{"entity":null}}}`)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"data":{"actor":{"entity":{"__typename":"GenericEntity","accountId":1,"guid":"MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ","name":"private-location"}}}}`)
+	})
+
+	return meta.NewClient, &polls
+}
+
+func TestWaitForSyntheticsPrivateLocationEntity(t *testing.T) {
+	t.Parallel()
+
+	client, polls := testMockSyntheticsPrivateLocationEntityClient(t, 2)
+
+	err := waitForSyntheticsPrivateLocationEntity(context.Background(), client, "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ", time.Minute)
+
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(polls))
+}
+
+func TestWaitForSyntheticsPrivateLocationEntity_Timeout(t *testing.T) {
+	t.Parallel()
+
+	client, _ := testMockSyntheticsPrivateLocationEntityClient(t, math.MaxInt32)
+
+	err := waitForSyntheticsPrivateLocationEntity(context.Background(), client, "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ", time.Second)
+
+	require.ErrorContains(t, err, "private location MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ is not queryable yet")
+}
+
+func TestWaitForSyntheticsPrivateLocationEntity_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	client, _ := testMockSyntheticsPrivateLocationEntityClient(t, math.MaxInt32)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := waitForSyntheticsPrivateLocationEntity(ctx, client, "MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfDEyMzQ", time.Minute)
+
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 10*time.Second)
+}
@@ -22,6 +22,7 @@ func resourceNewRelicSyntheticsScriptMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNewRelicSyntheticsMonitorRuntimeCustomizeDiff,
 		Schema: mergeSchemas(
 			syntheticsMonitorCommonSchema(),
 			syntheticsScriptMonitorCommonSchema(),
@@ -192,7 +193,7 @@ func resourceNewRelicSyntheticsScriptMonitorRead(ctx context.Context, d *schema.
 	}
 
 	// This should probably be in go-client, so we can use *errors.NotFound
-	if *resp == nil {
+	if isSyntheticsMonitorEntityDeleted(resp) {
 		d.SetId("")
 		return nil
 	}
@@ -265,6 +266,10 @@ func resourceNewRelicSyntheticsScriptMonitorUpdate(ctx context.Context, d *schem
 			return errors
 		}
 
+		if err := updateSyntheticsMonitorTags(ctx, &client.Entities, d); err != nil {
+			return diag.FromErr(err)
+		}
+
 		err = setSyntheticsMonitorAttributes(d, map[string]string{
 			"name":   resp.Monitor.Name,
 			"guid":   string(resp.Monitor.GUID),
@@ -290,6 +295,10 @@ func resourceNewRelicSyntheticsScriptMonitorUpdate(ctx context.Context, d *schem
 			return errors
 		}
 
+		if err := updateSyntheticsMonitorTags(ctx, &client.Entities, d); err != nil {
+			return diag.FromErr(err)
+		}
+
 		err = setSyntheticsMonitorAttributes(d, map[string]string{
 			"name":   resp.Monitor.Name,
 			"guid":   string(resp.Monitor.GUID),
@@ -314,10 +323,11 @@ func resourceNewRelicSyntheticsScriptMonitorDelete(ctx context.Context, d *schem
 
 	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
 
-	_, err := client.Synthetics.SyntheticsDeleteMonitorWithContext(ctx, guid)
-	if err != nil {
+	if err := deleteSyntheticsMonitor(ctx, &client.Synthetics, guid); err != nil {
 		return diag.FromErr(err)
 	}
 
+	d.SetId("")
+
 	return nil
 }
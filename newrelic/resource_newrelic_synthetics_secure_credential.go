@@ -149,6 +149,12 @@ func resourceNewRelicSyntheticsSecureCredentialRead(ctx context.Context, d *sche
 		}
 	}
 
+	if entity == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// The API never returns the value, so the configured value is kept in state.
 	return flattenSyntheticsSecureCredential(entity, d)
 }
 
@@ -200,7 +206,10 @@ func resourceNewRelicSyntheticsSecureCredentialDelete(ctx context.Context, d *sc
 
 	var diags diag.Diagnostics
 
-	res, _ := client.Synthetics.SyntheticsDeleteSecureCredentialWithContext(ctx, accountID, d.Id())
+	res, err := client.Synthetics.SyntheticsDeleteSecureCredentialWithContext(ctx, accountID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if res != nil {
 		for _, err := range res.Errors {
@@ -30,15 +30,22 @@ func TestAccNewRelicSyntheticsSecureCredential_Basic(t *testing.T) {
 				Config: testAccNewRelicSyntheticsSecureCredentialConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicSyntheticsSecureCredentialExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "Test Value"),
 				),
 			},
-			// Test: Update
+			// Test: Update the value in place
 			{
 				Config: testAccNewRelicSyntheticsSecureCredentialConfigUpdated(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicSyntheticsSecureCredentialExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "Test Value Updated"),
 				),
 			},
+			// Test: The value read back from the API doesn't drift
+			{
+				Config:   testAccNewRelicSyntheticsSecureCredentialConfigUpdated(rName),
+				PlanOnly: true,
+			},
 			// Test: Import
 			{
 				ResourceName:      resourceName,
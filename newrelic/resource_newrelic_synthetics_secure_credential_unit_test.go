@@ -0,0 +1,94 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func testSyntheticsSecureCredentialState() *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "MY_TOKEN",
+		Attributes: map[string]string{
+			"id":           "MY_TOKEN",
+			"account_id":   "1",
+			"key":          "MY_TOKEN",
+			"value":        "old-value",
+			"description":  "token",
+			"last_updated": "2023-01-01T00:00:00Z",
+		},
+	}
+}
+
+func TestSyntheticsSecureCredential_Diff(t *testing.T) {
+	r := resourceNewRelicSyntheticsSecureCredential()
+
+	// Changing the value updates the credential in place
+	diff, err := r.Diff(context.Background(), testSyntheticsSecureCredentialState(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":         "MY_TOKEN",
+		"value":       "new-value",
+		"description": "token",
+	}), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.False(t, diff.RequiresNew())
+	require.Equal(t, "new-value", diff.Attributes["value"].New)
+
+	// Changing the key replaces the credential
+	diff, err = r.Diff(context.Background(), testSyntheticsSecureCredentialState(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"key":         "OTHER_TOKEN",
+		"value":       "old-value",
+		"description": "token",
+	}), nil)
+	require.NoError(t, err)
+	require.True(t, diff.RequiresNew())
+}
+
+func testSyntheticsSecureCredentialMeta(t *testing.T, response string) *ProviderConfig {
+	return testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(response))
+	})
+}
+
+func TestSyntheticsSecureCredentialRead_KeepsValue(t *testing.T) {
+	t.Parallel()
+
+	meta := testSyntheticsSecureCredentialMeta(t, `{"data":{"actor":{"entitySearch":{"count":1,"results":{"entities":[{
+		"__typename":"SecureCredentialEntityOutline","accountId":1,"guid":"MXxTWU5USHxTRUNVUkVfQ1JFRHxNWV9UT0tFTg","name":"MY_TOKEN",
+		"description":"rotated token","updatedAt":1672531200000
+	}]}}}}}`)
+
+	r := resourceNewRelicSyntheticsSecureCredential()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"key":   "MY_TOKEN",
+		"value": "secret-value",
+	})
+	d.SetId("MY_TOKEN")
+
+	diags := resourceNewRelicSyntheticsSecureCredentialRead(context.Background(), d, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, "MY_TOKEN", d.Id())
+	require.Equal(t, "rotated token", d.Get("description"))
+	require.Equal(t, "secret-value", d.Get("value"))
+}
+
+func TestSyntheticsSecureCredentialDelete_Error(t *testing.T) {
+	t.Parallel()
+
+	meta := testSyntheticsSecureCredentialMeta(t, `{"errors":[{"message":"Access denied"}]}`)
+
+	d := resourceNewRelicSyntheticsSecureCredential().TestResourceData()
+	d.SetId("MY_TOKEN")
+
+	diags := resourceNewRelicSyntheticsSecureCredentialDelete(context.Background(), d, meta)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Access denied")
+	require.Equal(t, "MY_TOKEN", d.Id())
+}
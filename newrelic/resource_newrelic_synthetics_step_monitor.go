@@ -139,7 +139,7 @@ func resourceNewRelicSyntheticsStepMonitorRead(ctx context.Context, d *schema.Re
 	}
 
 	// This should probably be in go-client so we can use *errors.NotFound
-	if *resp == nil {
+	if isSyntheticsMonitorEntityDeleted(resp) {
 		d.SetId("")
 		return nil
 	}
@@ -186,6 +186,10 @@ func resourceNewRelicSyntheticsStepMonitorUpdate(ctx context.Context, d *schema.
 		return errors
 	}
 
+	if err := updateSyntheticsMonitorTags(ctx, &client.Entities, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_ = d.Set("locations_public", resp.Monitor.Locations.Public)
 	_ = d.Set("steps", flattenSyntheticsMonitorSteps(resp.Monitor.Steps))
 	_ = d.Set("period_in_minutes", syntheticsMonitorPeriodInMinutesValueMap[resp.Monitor.Period])
@@ -206,10 +210,11 @@ func resourceNewRelicSyntheticsStepMonitorDelete(ctx context.Context, d *schema.
 
 	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
 
-	_, err := client.Synthetics.SyntheticsDeleteMonitorWithContext(ctx, guid)
-	if err != nil {
+	if err := deleteSyntheticsMonitor(ctx, &client.Synthetics, guid); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diag.FromErr(err)
+	d.SetId("")
+
+	return nil
 }
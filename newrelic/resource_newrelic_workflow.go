@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +25,7 @@ func resourceNewRelicWorkflow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceNewRelicWorkflowCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"name": {
@@ -107,9 +109,9 @@ func resourceNewRelicWorkflow() *schema.Resource {
 										Description:  fmt.Sprintf("The type of the operator. One of: (%s).", strings.Join(listValidWorkflowsOperatorTypes(), ", ")),
 									},
 									"values": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Required:    true,
-										Description: "List of predicate values.",
+										Description: "Set of predicate values.",
 										Elem:        &schema.Schema{Type: schema.TypeString},
 									},
 								},
@@ -215,6 +217,11 @@ func resourceNewRelicWorkflow() *schema.Resource {
 				ForceNew:    true,
 				Description: "The account id of the workflow.",
 			},
+			"validate_destinations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to verify during plan that every destination's channel exists. Requires one API request per channel.",
+			},
 
 			// Computed
 			"last_run": {
@@ -451,6 +458,57 @@ func resourceNewRelicWorkflowV0() *schema.Resource {
 	}
 }
 
+// Validates the `issues_filter` predicates, and checks that each destination channel exists when
+// `validate_destinations` is enabled. The latter is opt-in since it requires an API request for
+// every channel in the workflow.
+func resourceNewRelicWorkflowCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.NewValueKnown("issues_filter") {
+		if err := validateWorkflowIssuesFilters(diff.Get("issues_filter").(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	if meta == nil || !diff.Get("validate_destinations").(bool) {
+		return nil
+	}
+
+	// Channels created in the same plan are not known yet
+	if !diff.HasChange("destination") || !diff.NewValueKnown("destination") {
+		return nil
+	}
+
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	accountID := diff.Get("account_id").(int)
+	if accountID == 0 {
+		accountID = providerConfig.AccountID
+	}
+	updatedContext := updateContextWithAccountID(ctx, accountID)
+
+	var channelIDs []string
+	for _, d := range diff.Get("destination").(*schema.Set).List() {
+		destination := d.(map[string]interface{})
+		if channelID := destination["channel_id"].(string); channelID != "" {
+			channelIDs = append(channelIDs, channelID)
+		}
+	}
+
+	return validateWorkflowDestinationChannels(channelIDs, func(channelID string) (bool, error) {
+		filters := ai.AiNotificationsChannelFilter{ID: channelID}
+		channelResponse, err := client.Notifications.GetChannelsWithContext(updatedContext, accountID, "", filters, notifications.AiNotificationsChannelSorter{})
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return len(channelResponse.Entities) > 0, nil
+	})
+}
+
 func resourceNewRelicWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
 	workflowInput, err := expandWorkflow(d)
@@ -6,6 +6,7 @@ package newrelic
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -95,6 +96,32 @@ func TestNewRelicWorkflow_DeleteWorkflowWithoutRemovingChannels(t *testing.T) {
 	})
 }
 
+func TestNewRelicWorkflow_DestroyWithChannelAndDestination(t *testing.T) {
+	resourceName := "newrelic_workflow.foo"
+	channelResourceName := "foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		// The workflow references the channel and the channel references the destination,
+		// so the whole chain is destroyed in one apply, dependents first.
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccNewRelicWorkflowDestroy,
+			testAccNewRelicNotificationChannelDestroy,
+			testAccNewRelicNotificationDestinationDestroy,
+		),
+		Steps: []resource.TestStep{
+			// Test: Create workflow, channel and destination
+			{
+				Config: testAccNewRelicChannelConfigurationEmail(channelResourceName) + testAccNewRelicWorkflowConfiguration(channelResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicWorkflowExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
 func TestNewRelicWorkflow_MinimalConfig(t *testing.T) {
 	resourceName := "newrelic_workflow.foo"
 	rName := generateNameForIntegrationTestResource()
@@ -213,6 +240,36 @@ enrichments {
 	})
 }
 
+func TestNewRelicWorkflow_EnrichmentInNonDefaultAccount(t *testing.T) {
+	resourceName := "newrelic_workflow.foo"
+	workflowName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvVars(t)
+			if testSubAccountID == 0 {
+				t.Skipf("NEW_RELIC_SUBACCOUNT_ID must be set to create a workflow in another account")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicWorkflowDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create workflow with an enrichment in a non-default account
+			{
+				Config: testAccNewRelicWorkflowConfigurationEnrichmentAccount(testSubAccountID, workflowName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", strconv.Itoa(testSubAccountID)),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "enrichments.*", map[string]string{
+						"nrql.0.name":       "Log Count",
+						"nrql.0.account_id": strconv.Itoa(testSubAccountID),
+					}),
+				),
+			},
+		},
+	})
+}
+
 // This test doesnt seem valid anymore because it looks like the API automatically generates a
 // filter name is one is not provided. Skipping this test for now, but we probably can remove
 // this test at some point.
@@ -416,6 +473,69 @@ resource "newrelic_workflow" "foo" {
 `, accountID, name)
 }
 
+func testAccNewRelicWorkflowConfigurationEnrichmentAccount(accountID int, name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_notification_destination" "foo" {
+  account_id = %[1]d
+  name       = "tf-test-destination"
+  type       = "WEBHOOK"
+
+  property {
+    key   = "url"
+    value = "https://webhook.site/"
+  }
+
+  auth_basic {
+    user     = "username"
+    password = "password"
+  }
+}
+
+resource "newrelic_notification_channel" "foo" {
+  account_id     = %[1]d
+  name           = "webhook-example"
+  type           = "WEBHOOK"
+  product        = "IINT"
+  destination_id = newrelic_notification_destination.foo.id
+
+  property {
+    key   = "payload"
+    value = "{}"
+  }
+}
+
+resource "newrelic_workflow" "foo" {
+  account_id            = %[1]d
+  name                  = "%[2]s"
+  muting_rules_handling = "NOTIFY_ALL_ISSUES"
+
+  issues_filter {
+    name = "filter-name"
+    type = "FILTER"
+
+    predicate {
+      attribute = "accumulations.sources"
+      operator  = "EQUAL"
+      values    = ["newrelic"]
+    }
+  }
+
+  enrichments {
+    nrql {
+      name = "Log Count"
+      configuration {
+        query = "SELECT count(*) FROM Log"
+      }
+    }
+  }
+
+  destination {
+    channel_id = newrelic_notification_channel.foo.id
+  }
+}
+`, accountID, name)
+}
+
 func testAccNewRelicWorkflowConfigurationInvalidIssuesFilterAttr(accountID int, name string) string {
 	return fmt.Sprintf(`
 resource "newrelic_notification_destination" "foo" {
@@ -803,11 +923,15 @@ func testAccNewRelicWorkflowDestroy(s *terraform.State) error {
 			continue
 		}
 
+		accountID := testAccountID
+		if stateAccountID, err := strconv.Atoi(r.Primary.Attributes["account_id"]); err == nil && stateAccountID != 0 {
+			accountID = stateAccountID
+		}
 		filters := ai.AiWorkflowsFilters{
 			ID: r.Primary.ID,
 		}
 
-		resp, err := client.Workflows.GetWorkflows(testAccountID, "", filters)
+		resp, err := client.Workflows.GetWorkflows(accountID, "", filters)
 		if len(resp.Entities) > 0 {
 			return fmt.Errorf("workflow still exists")
 		}
@@ -836,6 +960,9 @@ func testAccCheckNewRelicWorkflowExists(n string) resource.TestCheckFunc {
 		var accountID int
 		id := rs.Primary.ID
 		accountID = providerConfig.AccountID
+		if stateAccountID, err := strconv.Atoi(rs.Primary.Attributes["account_id"]); err == nil && stateAccountID != 0 {
+			accountID = stateAccountID
+		}
 		filters := ai.AiWorkflowsFilters{
 			ID: id,
 		}
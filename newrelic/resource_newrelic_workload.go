@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +21,7 @@ func resourceNewRelicWorkload() *schema.Resource {
 		ReadContext:   resourceNewRelicWorkloadRead,
 		UpdateContext: resourceNewRelicWorkloadUpdate,
 		DeleteContext: resourceNewRelicWorkloadDelete,
+		CustomizeDiff: resourceNewRelicWorkloadCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -170,6 +172,11 @@ func resourceNewRelicWorkload() *schema.Resource {
 					},
 				},
 			},
+			"validate_nrql": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to verify during plan that the entity search queries of the automatic status rules are valid. Requires one API request per query.",
+			},
 			"workload_id": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -238,6 +245,60 @@ func WorkloadRollupInputSchemaElem() map[string]*schema.Schema {
 	}
 }
 
+// Checks the entity search queries of the automatic status rules when `validate_nrql` is enabled.
+// This is opt-in since it requires an API request for every query in the rules.
+func resourceNewRelicWorkloadCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil || !diff.Get("validate_nrql").(bool) {
+		return nil
+	}
+
+	// Queries interpolated from other resources are not known yet
+	if !diff.HasChange("status_config_automatic") || !diff.NewValueKnown("status_config_automatic") {
+		return nil
+	}
+
+	client := meta.(*ProviderConfig).NewClient
+
+	var rules []interface{}
+	for _, c := range diff.Get("status_config_automatic").(*schema.Set).List() {
+		cfg := c.(map[string]interface{})
+		if r, ok := cfg["rule"]; ok {
+			rules = append(rules, r.(*schema.Set).List()...)
+		}
+	}
+
+	return validateWorkloadRuleQueries(rules, func(query string) error {
+		_, err := client.Entities.GetEntitySearchByQueryWithContext(ctx, entities.EntitySearchOptions{Limit: 1}, query, []entities.EntitySearchSortCriteria{})
+		return err
+	})
+}
+
+// Runs every rule's entity search query through search, reporting the first one that fails
+// along with the index of the rule it belongs to.
+func validateWorkloadRuleQueries(rules []interface{}, search func(query string) error) error {
+	for i, r := range rules {
+		rule := r.(map[string]interface{})
+
+		queries, ok := rule["nrql_query"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, q := range queries.List() {
+			query := q.(map[string]interface{})["query"].(string)
+			if query == "" {
+				continue
+			}
+
+			if err := search(query); err != nil {
+				return fmt.Errorf("status_config_automatic rule %d: invalid nrql_query %q: %w", i, query, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceNewRelicWorkloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
 	createInput := expandWorkloadCreateInput(d)
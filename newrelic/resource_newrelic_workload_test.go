@@ -54,6 +54,38 @@ func TestAccNewRelicWorkload_Basic(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicWorkload_Import(t *testing.T) {
+	resourceName := "newrelic_workload.foo"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicWorkloadDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create with entities, search queries, scope accounts and status configs
+			{
+				Config: testAccNewRelicWorkloadConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicWorkloadExists(resourceName),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+			},
+			// Test: The imported workload matches the configuration
+			{
+				Config:   testAccNewRelicWorkloadConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccNewRelicWorkload_EntitiesOnly(t *testing.T) {
 	resourceName := "newrelic_workload.foo"
 	rName := generateNameForIntegrationTestResource()
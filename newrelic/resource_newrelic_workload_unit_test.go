@@ -0,0 +1,71 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWorkloadRuleQueriesConfig(validate bool) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          "workload",
+		"entity_guids":  []interface{}{"MjUyMDUyOHxBUE18QVBQTElDQVRJT058MQ"},
+		"validate_nrql": validate,
+		"status_config_automatic": []interface{}{
+			map[string]interface{}{
+				"enabled": true,
+				"rule": []interface{}{
+					map[string]interface{}{
+						"nrql_query": []interface{}{
+							map[string]interface{}{"query": "name like 'broken"},
+						},
+						"rollup": []interface{}{
+							map[string]interface{}{"strategy": "BEST_STATUS_WINS"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResourceNewRelicWorkloadCustomizeDiff_ValidateNrql(t *testing.T) {
+	t.Parallel()
+
+	var searches int32
+	meta := testProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.True(t, strings.Contains(body.Query, "entitySearch"), body.Query)
+
+		atomic.AddInt32(&searches, 1)
+		assert.Equal(t, "name like 'broken", body.Variables["query"])
+
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid entity search query"}]}`))
+	})
+
+	r := resourceNewRelicWorkload()
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(testWorkloadRuleQueriesConfig(false)), meta)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), atomic.LoadInt32(&searches))
+
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(testWorkloadRuleQueriesConfig(true)), meta)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "status_config_automatic rule 0")
+	require.Contains(t, err.Error(), "Invalid entity search query")
+	require.Equal(t, int32(1), atomic.LoadInt32(&searches))
+}
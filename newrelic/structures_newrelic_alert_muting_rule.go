@@ -270,16 +270,20 @@ func flattenMutingRule(mutingRule *alerts.MutingRule, d *schema.ResourceData) er
 	_ = d.Set("enabled", mutingRule.Enabled)
 	err := d.Set("condition", flattenMutingRuleConditionGroup(mutingRule.Condition, configuredCondition))
 	if err != nil {
-		return nil
+		return fmt.Errorf("[Error] Error setting `condition`: %v", err)
 	}
 
 	_ = d.Set("description", mutingRule.Description)
 	_ = d.Set("name", mutingRule.Name)
 
+	// A rule without a schedule clears any schedule left over in state.
+	var schedule []interface{}
 	if mutingRule.Schedule != nil {
-		if err := d.Set("schedule", flattenSchedule(mutingRule.Schedule)); err != nil {
-			return fmt.Errorf("[Error] Error setting `schedule`: %v", err)
-		}
+		schedule = flattenSchedule(mutingRule.Schedule)
+	}
+
+	if err := d.Set("schedule", schedule); err != nil {
+		return fmt.Errorf("[Error] Error setting `schedule`: %v", err)
 	}
 
 	return nil
@@ -350,3 +350,100 @@ func TestExpandScheduleUpdate_EmptyWeeklyRepeat(t *testing.T) {
 	require.Equal(t, expected, result)
 
 }
+
+func TestFlattenMutingRule_Import(t *testing.T) {
+	t.Parallel()
+
+	startTime, _ := time.Parse(time.RFC3339, "2021-01-21T15:30:00+01:00")
+	endTime, _ := time.Parse(time.RFC3339, "2021-01-21T16:30:00+01:00")
+	repeat := alerts.MutingRuleScheduleRepeat("WEEKLY")
+	repeatCount := 10
+
+	mutingRule := alerts.MutingRule{
+		Name:        "weekly muting rule",
+		Description: "imported",
+		Enabled:     true,
+		Condition: alerts.MutingRuleConditionGroup{
+			Operator: "AND",
+			Conditions: []alerts.MutingRuleCondition{
+				{
+					Attribute: "product",
+					Operator:  "EQUALS",
+					Values:    []string{"APM"},
+				},
+			},
+		},
+		Schedule: &alerts.MutingRuleSchedule{
+			StartTime:        &startTime,
+			EndTime:          &endTime,
+			TimeZone:         "Europe/Berlin",
+			Repeat:           &repeat,
+			RepeatCount:      &repeatCount,
+			WeeklyRepeatDays: &[]alerts.DayOfWeek{"MONDAY", "THURSDAY"},
+		},
+	}
+
+	// An imported resource has no configuration to fall back on.
+	d := schema.TestResourceDataRaw(t, resourceNewRelicAlertMutingRule().Schema, map[string]interface{}{})
+
+	require.NoError(t, flattenMutingRule(&mutingRule, d))
+
+	require.Equal(t, "AND", d.Get("condition.0.operator"))
+	require.Equal(t, "product", d.Get("condition.0.conditions.0.attribute"))
+	require.Equal(t, "EQUALS", d.Get("condition.0.conditions.0.operator"))
+	require.Equal(t, []interface{}{"APM"}, d.Get("condition.0.conditions.0.values"))
+	require.Equal(t, "2021-01-21T15:30:00", d.Get("schedule.0.start_time"))
+	require.Equal(t, "2021-01-21T16:30:00", d.Get("schedule.0.end_time"))
+	require.Equal(t, "Europe/Berlin", d.Get("schedule.0.time_zone"))
+	require.Equal(t, "WEEKLY", d.Get("schedule.0.repeat"))
+	require.Equal(t, 10, d.Get("schedule.0.repeat_count"))
+	require.ElementsMatch(t, []interface{}{"MONDAY", "THURSDAY"}, d.Get("schedule.0.weekly_repeat_days").(*schema.Set).List())
+}
+
+func TestFlattenMutingRule_WithoutSchedule(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicAlertMutingRule().Schema, map[string]interface{}{
+		"schedule": []interface{}{
+			map[string]interface{}{"time_zone": "Europe/Berlin"},
+		},
+	})
+
+	require.NoError(t, flattenMutingRule(&alerts.MutingRule{Condition: alerts.MutingRuleConditionGroup{Operator: "AND"}}, d))
+	require.Empty(t, d.Get("schedule"))
+}
+
+func TestExpandMutingRuleUpdateInput_Disabled(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicAlertMutingRule().Schema, map[string]interface{}{
+		"name":        "muting rule",
+		"enabled":     false,
+		"description": "updated description",
+		"condition": []interface{}{
+			map[string]interface{}{
+				"operator": "AND",
+				"conditions": []interface{}{
+					map[string]interface{}{"attribute": "product", "operator": "EQUALS", "values": []interface{}{"APM"}},
+				},
+			},
+		},
+		"schedule": []interface{}{
+			map[string]interface{}{"time_zone": "Europe/Berlin", "repeat": "DAILY"},
+		},
+	})
+
+	updateInput, err := expandMutingRuleUpdateInput(d)
+	require.NoError(t, err)
+	require.False(t, updateInput.Enabled)
+	require.Equal(t, "updated description", updateInput.Description)
+	require.NotNil(t, updateInput.Condition)
+	require.Equal(t, "product", updateInput.Condition.Conditions[0].Attribute)
+	require.NotNil(t, updateInput.Schedule)
+	require.Equal(t, "Europe/Berlin", *updateInput.Schedule.TimeZone)
+
+	// The update mutation always sends `enabled`, so disabling a rule does not require recreating it.
+	for name, attr := range resourceNewRelicAlertMutingRule().Schema {
+		require.False(t, attr.ForceNew, name)
+	}
+}
@@ -16,7 +16,7 @@ func expandCloudAwsIntegrationsInput(d *schema.ResourceData) (cloud.CloudIntegra
 		linkedAccountID = l.(int)
 	}
 
-	awsIntegrationMap := map[string]enableDisableAwsIntegration{
+	awsIntegrationMap := map[string]cloudIntegrationSpec{
 		"billing": {
 			enableFunc: func(a []interface{}, id int) {
 				cloudAwsIntegration.Billing = expandCloudAwsIntegrationBillingInput(a, id)
@@ -435,13 +435,7 @@ func expandCloudAwsIntegrationsInput(d *schema.ResourceData) (cloud.CloudIntegra
 		},
 	}
 
-	for key, fun := range awsIntegrationMap {
-		if v, ok := d.GetOk(key); ok {
-			fun.enableFunc(v.([]interface{}), linkedAccountID)
-		} else if o, n := d.GetChange(key); len(n.([]interface{})) > len(o.([]interface{})) {
-			fun.disableFunc(linkedAccountID)
-		}
-	}
+	reconcileCloudIntegrations(d, linkedAccountID, awsIntegrationMap)
 
 	configureInput := cloud.CloudIntegrationsInput{
 		Aws: cloudAwsIntegration,
@@ -460,6 +454,7 @@ func expandCloudAwsIntegrationsInput(d *schema.ResourceData) (cloud.CloudIntegra
 func flattenCloudAwsLinkedAccount(d *schema.ResourceData, linkedAccount *cloud.CloudLinkedAccount) {
 	_ = d.Set("account_id", linkedAccount.NrAccountId)
 	_ = d.Set("linked_account_id", linkedAccount.ID)
+	_ = d.Set("integration_status", flattenCloudIntegrationStatus(linkedAccount.Integrations))
 
 	for _, i := range linkedAccount.Integrations {
 		switch t := i.(type) {
@@ -798,11 +793,6 @@ func buildDeleteInput(d *schema.ResourceData) cloud.CloudDisableIntegrationsInpu
 	return deleteInput
 }
 
-type enableDisableAwsIntegration struct {
-	enableFunc  func([]interface{}, int)
-	disableFunc func(int)
-}
-
 // Expanding the Billing input
 
 func expandCloudAwsIntegrationBillingInput(b []interface{}, linkedAccountID int) []cloud.CloudBillingIntegrationInput {
@@ -849,7 +839,7 @@ func expandCloudAwsIntegrationCloudtrailInput(c []interface{}, linkedAccountID i
 		cloudtrailInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -916,7 +906,7 @@ func expandCloudAwsIntegrationDocDBInput(h []interface{}, linkedAccountID int) [
 			docDbInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1022,7 +1012,7 @@ func expandCloudAwsIntegrationVpcInput(v []interface{}, linkedAccountID int) []c
 		vpcInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1075,7 +1065,7 @@ func expandCloudAwsIntegrationXRayInput(x []interface{}, linkedAccountID int) []
 		xrayInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1112,7 +1102,7 @@ func expandCloudAwsIntegrationSqsInput(h []interface{}, linkedAccountID int) []c
 		sqsInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1175,7 +1165,7 @@ func expandCloudAwsIntegrationEbsInput(h []interface{}, linkedAccountID int) []c
 		ebsInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1224,7 +1214,7 @@ func expandCloudAwsIntegrationAlbInput(h []interface{}, linkedAccountID int) []c
 		albInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1288,7 +1278,7 @@ func expandCloudAwsIntegrationElasticacheInput(h []interface{}, linkedAccountID
 		elasticacheInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1337,7 +1327,7 @@ func expandCloudAwsIntegrationsAPIGatewayInput(b []interface{}, linkedAccountID
 		apiGatewayInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1397,7 +1387,7 @@ func expandCloudAwsIntegrationAutoscalingInput(b []interface{}, linkedAccountID
 			autoScalingInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1434,7 +1424,7 @@ func expandCloudAwsIntegrationAppsyncInput(b []interface{}, linkedAccountID int)
 			appSyncInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1471,7 +1461,7 @@ func expandCloudAwsIntegrationAthenaInput(b []interface{}, linkedAccountID int)
 			athenaInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1508,7 +1498,7 @@ func expandCloudAwsIntegrationCognitoInput(b []interface{}, linkedAccountID int)
 			cognitoInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1545,7 +1535,7 @@ func expandCloudAwsIntegrationConnectInput(b []interface{}, linkedAccountID int)
 			connectInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1582,7 +1572,7 @@ func expandCloudAwsIntegrationDirectconnectInput(b []interface{}, linkedAccountI
 			directConnectInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1619,7 +1609,7 @@ func expandCloudAwsIntegrationFsxInput(b []interface{}, linkedAccountID int) []c
 			fsxInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1656,7 +1646,7 @@ func expandCloudAwsIntegrationGlueInput(b []interface{}, linkedAccountID int) []
 			glueInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1693,7 +1683,7 @@ func expandCloudAwsIntegrationKinesisAnalyticsInput(b []interface{}, linkedAccou
 			kinesisAnalyticsInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1730,7 +1720,7 @@ func expandCloudAwsIntegrationMediaConvertInput(b []interface{}, linkedAccountID
 			mediaConvertInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1767,7 +1757,7 @@ func expandCloudAwsIntegrationMediaPackageVodInput(b []interface{}, linkedAccoun
 			mediaPackageVodInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1804,7 +1794,7 @@ func expandCloudAwsIntegrationMqInput(b []interface{}, linkedAccountID int) []cl
 			mqInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1841,7 +1831,7 @@ func expandCloudAwsIntegrationMskInput(b []interface{}, linkedAccountID int) []c
 			mskInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1878,7 +1868,7 @@ func expandCloudAwsIntegrationNeptuneInput(b []interface{}, linkedAccountID int)
 			neptuneInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1915,7 +1905,7 @@ func expandCloudAwsIntegrationQldbInput(b []interface{}, linkedAccountID int) []
 			qldbInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1952,7 +1942,7 @@ func expandCloudAwsIntegrationRoute53resolverInput(b []interface{}, linkedAccoun
 			route53resolverInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -1989,7 +1979,7 @@ func expandCloudAwsIntegrationStatesInput(b []interface{}, linkedAccountID int)
 			statesInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2026,7 +2016,7 @@ func expandCloudAwsIntegrationTransitGatewayInput(b []interface{}, linkedAccount
 			transitGatewayInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2063,7 +2053,7 @@ func expandCloudAwsIntegrationWafInput(b []interface{}, linkedAccountID int) []c
 			wafInput.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2100,7 +2090,7 @@ func expandCloudAwsIntegrationWafv2Input(b []interface{}, linkedAccountID int) [
 			wafv2Input.MetricsPollingInterval = m.(int)
 		}
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2178,7 +2168,7 @@ func expandCloudAwsIntegrationDynamoDBInput(b []interface{}, linkedAccountID int
 		dynamodbInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2232,7 +2222,7 @@ func expandCloudAwsIntegrationEc2Input(b []interface{}, linkedAccountID int) []c
 		ec2Input.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2286,7 +2276,7 @@ func expandCloudAwsIntegrationEcsInput(b []interface{}, linkedAccountID int) []c
 		ecsInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2336,7 +2326,7 @@ func expandCloudAwsIntegrationEfsInput(b []interface{}, linkedAccountID int) []c
 		efsInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2386,7 +2376,7 @@ func expandCloudAwsIntegrationElasticbeanstalkInput(b []interface{}, linkedAccou
 		elasticbeanstalkInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2440,7 +2430,7 @@ func expandCloudAwsIntegrationElasticsearchInput(b []interface{}, linkedAccountI
 		elasticsearchInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2490,7 +2480,7 @@ func expandCloudAwsIntegrationElbInput(b []interface{}, linkedAccountID int) []c
 		elbInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2536,7 +2526,7 @@ func expandCloudAwsIntegrationEmrInput(b []interface{}, linkedAccountID int) []c
 		emrInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2622,7 +2612,7 @@ func expandCloudAwsIntegrationIotInput(b []interface{}, linkedAccountID int) []c
 		iotInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2659,7 +2649,7 @@ func expandCloudAwsIntegrationKinesisInput(b []interface{}, linkedAccountID int)
 		kinesisInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2713,7 +2703,7 @@ func expandCloudAwsIntegrationKinesisFirehoseInput(b []interface{}, linkedAccoun
 		firehoseInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2751,7 +2741,7 @@ func expandCloudAwsIntegrationLambdaInput(b []interface{}, linkedAccountID int)
 		lambdaInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2801,7 +2791,7 @@ func expandCloudAwsIntegrationRdsInput(b []interface{}, linkedAccountID int) []c
 		rdsInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2851,7 +2841,7 @@ func expandCloudAwsIntegrationRedshiftInput(b []interface{}, linkedAccountID int
 		redshiftInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2929,7 +2919,7 @@ func expandCloudAwsIntegrationSesInput(b []interface{}, linkedAccountID int) []c
 		sesInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
@@ -2967,7 +2957,7 @@ func expandCloudAwsIntegrationSnsInput(b []interface{}, linkedAccountID int) []c
 		snsInput.LinkedAccountId = linkedAccountID
 
 		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
+			awsRegions := a.(*schema.Set).List()
 			var regions []string
 
 			for _, region := range awsRegions {
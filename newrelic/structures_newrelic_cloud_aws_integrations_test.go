@@ -0,0 +1,212 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandCloudAwsIntegrationsInput_AwsRegions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicCloudAwsIntegrations().Schema, map[string]interface{}{
+		"linked_account_id": 12345,
+		"sqs": []interface{}{
+			map[string]interface{}{
+				"aws_regions":              []interface{}{"us-east-1", "us-west-2"},
+				"metrics_polling_interval": 300,
+			},
+		},
+	})
+
+	configureInput, _ := expandCloudAwsIntegrationsInput(d)
+
+	require.Len(t, configureInput.Aws.Sqs, 1)
+	require.Equal(t, 12345, configureInput.Aws.Sqs[0].LinkedAccountId)
+	require.ElementsMatch(t, []string{"us-east-1", "us-west-2"}, configureInput.Aws.Sqs[0].AwsRegions)
+}
+
+func TestFlattenCloudAwsSqsIntegration_AwsRegions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicCloudAwsIntegrations().Schema, map[string]interface{}{})
+
+	err := d.Set("sqs", flattenCloudAwsSqsIntegration(&cloud.CloudSqsIntegration{
+		AwsRegions:             []string{"us-west-2", "us-east-1"},
+		MetricsPollingInterval: 300,
+	}))
+	require.NoError(t, err)
+
+	regions := d.Get("sqs.0.aws_regions").(*schema.Set)
+	require.Equal(t, 2, regions.Len())
+	require.True(t, regions.Contains("us-east-1"))
+	require.True(t, regions.Contains("us-west-2"))
+}
+
+func TestCloudAwsIntegrationsSqsAndRdsRoundTrip(t *testing.T) {
+	r := resourceNewRelicCloudAwsIntegrations()
+	raw := map[string]interface{}{
+		"linked_account_id": 123,
+		"sqs": []interface{}{
+			map[string]interface{}{
+				"aws_regions":              []interface{}{"us-east-1"},
+				"fetch_extended_inventory": true,
+				"fetch_tags":               true,
+				"metrics_polling_interval": 300,
+				"queue_prefixes":           []interface{}{"orders-", "payments-"},
+				"tag_key":                  "team",
+				"tag_value":                "checkout",
+			},
+		},
+		"rds": []interface{}{
+			map[string]interface{}{
+				"aws_regions":              []interface{}{"eu-west-1"},
+				"fetch_tags":               true,
+				"metrics_polling_interval": 900,
+				"tag_key":                  "env",
+				"tag_value":                "production",
+			},
+		},
+	}
+
+	configureInput, _ := expandCloudAwsIntegrationsInput(schema.TestResourceDataRaw(t, r.Schema, raw))
+
+	require.Equal(t, []cloud.CloudSqsIntegrationInput{{
+		AwsRegions:             []string{"us-east-1"},
+		FetchExtendedInventory: true,
+		FetchTags:              true,
+		LinkedAccountId:        123,
+		MetricsPollingInterval: 300,
+		QueuePrefixes:          []string{"orders-", "payments-"},
+		TagKey:                 "team",
+		TagValue:               "checkout",
+	}}, configureInput.Aws.Sqs)
+	require.Equal(t, []cloud.CloudRdsIntegrationInput{{
+		AwsRegions:             []string{"eu-west-1"},
+		FetchTags:              true,
+		LinkedAccountId:        123,
+		MetricsPollingInterval: 900,
+		TagKey:                 "env",
+		TagValue:               "production",
+	}}, configureInput.Aws.Rds)
+
+	d := r.TestResourceData()
+	flattenCloudAwsLinkedAccount(d, &cloud.CloudLinkedAccount{
+		ID: 123,
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudSqsIntegration{
+				AwsRegions:             []string{"us-east-1"},
+				FetchExtendedInventory: true,
+				FetchTags:              true,
+				MetricsPollingInterval: 300,
+				QueuePrefixes:          []string{"orders-", "payments-"},
+				TagKey:                 "team",
+				TagValue:               "checkout",
+			},
+			&cloud.CloudRdsIntegration{
+				AwsRegions:             []string{"eu-west-1"},
+				FetchTags:              true,
+				MetricsPollingInterval: 900,
+				TagKey:                 "env",
+				TagValue:               "production",
+			},
+		},
+	})
+
+	require.Equal(t, []interface{}{"us-east-1"}, d.Get("sqs.0.aws_regions").(*schema.Set).List())
+	require.Equal(t, []interface{}{"orders-", "payments-"}, d.Get("sqs.0.queue_prefixes"))
+	require.Equal(t, true, d.Get("sqs.0.fetch_extended_inventory"))
+	require.Equal(t, "checkout", d.Get("sqs.0.tag_value"))
+	require.Equal(t, []interface{}{"eu-west-1"}, d.Get("rds.0.aws_regions").(*schema.Set).List())
+	require.Equal(t, 900, d.Get("rds.0.metrics_polling_interval"))
+	require.Equal(t, "env", d.Get("rds.0.tag_key"))
+	require.Equal(t, "production", d.Get("rds.0.tag_value"))
+}
+
+// EC2 collects its extended inventory through duplicate_ec2_tags and fetch_ip_addresses, while
+// Lambda filters functions by region and tag next to fetch_tags.
+
+func TestCloudAwsIntegrationsEc2AndLambdaRoundTrip(t *testing.T) {
+	r := resourceNewRelicCloudAwsIntegrations()
+	raw := map[string]interface{}{
+		"linked_account_id": 123,
+		"ec2": []interface{}{
+			map[string]interface{}{
+				"aws_regions":              []interface{}{"us-east-1"},
+				"duplicate_ec2_tags":       true,
+				"fetch_ip_addresses":       true,
+				"metrics_polling_interval": 300,
+				"tag_key":                  "team",
+				"tag_value":                "checkout",
+			},
+		},
+		"lambda": []interface{}{
+			map[string]interface{}{
+				"aws_regions":              []interface{}{"eu-west-1", "eu-central-1", "us-west-2"},
+				"fetch_tags":               true,
+				"metrics_polling_interval": 600,
+				"tag_key":                  "env",
+				"tag_value":                "production",
+			},
+		},
+	}
+
+	configureInput, _ := expandCloudAwsIntegrationsInput(schema.TestResourceDataRaw(t, r.Schema, raw))
+
+	require.Equal(t, []cloud.CloudEc2IntegrationInput{{
+		AwsRegions:             []string{"us-east-1"},
+		DuplicateEc2Tags:       true,
+		FetchIpAddresses:       true,
+		LinkedAccountId:        123,
+		MetricsPollingInterval: 300,
+		TagKey:                 "team",
+		TagValue:               "checkout",
+	}}, configureInput.Aws.Ec2)
+	require.Len(t, configureInput.Aws.Lambda, 1)
+	require.ElementsMatch(t, []string{"eu-west-1", "eu-central-1", "us-west-2"}, configureInput.Aws.Lambda[0].AwsRegions)
+	require.True(t, configureInput.Aws.Lambda[0].FetchTags)
+	require.Equal(t, 123, configureInput.Aws.Lambda[0].LinkedAccountId)
+	require.Equal(t, 600, configureInput.Aws.Lambda[0].MetricsPollingInterval)
+	require.Equal(t, "env", configureInput.Aws.Lambda[0].TagKey)
+	require.Equal(t, "production", configureInput.Aws.Lambda[0].TagValue)
+
+	d := r.TestResourceData()
+	flattenCloudAwsLinkedAccount(d, &cloud.CloudLinkedAccount{
+		ID: 123,
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudEc2Integration{
+				AwsRegions:             []string{"us-east-1"},
+				DuplicateEc2Tags:       true,
+				FetchIpAddresses:       true,
+				MetricsPollingInterval: 300,
+				TagKey:                 "team",
+				TagValue:               "checkout",
+			},
+			&cloud.CloudLambdaIntegration{
+				AwsRegions:             []string{"eu-west-1", "eu-central-1", "us-west-2"},
+				FetchTags:              true,
+				MetricsPollingInterval: 600,
+				TagKey:                 "env",
+				TagValue:               "production",
+			},
+		},
+	})
+
+	require.Equal(t, []interface{}{"us-east-1"}, d.Get("ec2.0.aws_regions").(*schema.Set).List())
+	require.Equal(t, true, d.Get("ec2.0.duplicate_ec2_tags"))
+	require.Equal(t, true, d.Get("ec2.0.fetch_ip_addresses"))
+	require.Equal(t, 300, d.Get("ec2.0.metrics_polling_interval"))
+	require.Equal(t, "team", d.Get("ec2.0.tag_key"))
+	require.Equal(t, "checkout", d.Get("ec2.0.tag_value"))
+	require.ElementsMatch(t, []interface{}{"eu-west-1", "eu-central-1", "us-west-2"}, d.Get("lambda.0.aws_regions").(*schema.Set).List())
+	require.Equal(t, true, d.Get("lambda.0.fetch_tags"))
+	require.Equal(t, 600, d.Get("lambda.0.metrics_polling_interval"))
+	require.Equal(t, "env", d.Get("lambda.0.tag_key"))
+	require.Equal(t, "production", d.Get("lambda.0.tag_value"))
+
+	// The flattened blocks expand back to the same input, so a plan after reading is clean.
+	reconfigured, _ := expandCloudAwsIntegrationsInput(d)
+	require.Equal(t, configureInput.Aws.Ec2, reconfigured.Aws.Ec2)
+	require.ElementsMatch(t, configureInput.Aws.Lambda[0].AwsRegions, reconfigured.Aws.Lambda[0].AwsRegions)
+}
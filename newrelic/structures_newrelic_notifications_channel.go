@@ -22,7 +22,12 @@ func expandNotificationChannelUpdate(d *schema.ResourceData) notifications.AiNot
 		Name:   d.Get("name").(string),
 		Active: d.Get("active").(bool),
 	}
-	channel.Properties = expandNotificationDestinationProperties(d.Get("property").(*schema.Set).List())
+
+	// Properties are only sent when they change, so write-only values, e.g. a Slack OAuth2 token,
+	// aren't re-sent when only the name or the active flag changes
+	if d.HasChange("property") {
+		channel.Properties = expandNotificationChannelProperties(d.Get("property").(*schema.Set).List())
+	}
 
 	return channel
 }
@@ -82,7 +87,8 @@ func flattenNotificationChannel(channel *notifications.AiNotificationsChannel, d
 		return err
 	}
 
-	if err := d.Set("property", flattenNotificationChannelProperties(channel.Properties)); err != nil {
+	priorProperties := d.Get("property").(*schema.Set).List()
+	if err := d.Set("property", preserveNotificationSecretProperties(flattenNotificationChannelProperties(channel.Properties), priorProperties)); err != nil {
 		return err
 	}
 
@@ -4,8 +4,10 @@
 package newrelic
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
 	"github.com/stretchr/testify/assert"
@@ -83,6 +85,36 @@ func TestExpandNotificationChannel(t *testing.T) {
 	}
 }
 
+func TestExpandNotificationChannelUpdate(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "webhook-test",
+		"property": []interface{}{
+			map[string]interface{}{
+				"key":   "payload",
+				"value": "{ \"id\": \"updated\" }",
+				"label": "Payload Template",
+			},
+		},
+	})
+
+	expanded := expandNotificationChannelUpdate(d)
+	expanded.Properties = appendMonitoringProperty(expanded.Properties)
+
+	assert.Equal(t, "webhook-test", expanded.Name)
+	assert.Equal(t, []notifications.AiNotificationsPropertyInput{
+		{
+			Key:   "payload",
+			Value: "{ \"id\": \"updated\" }",
+			Label: "Payload Template",
+		},
+		createMonitoringProperty(),
+	}, expanded.Properties)
+
+	// The monitoring property is not duplicated when it is already present
+	assert.Len(t, appendMonitoringProperty(expanded.Properties), 2)
+}
+
 func TestFlattenNotificationChannel(t *testing.T) {
 	r := resourceNewRelicNotificationChannel()
 
@@ -124,3 +156,115 @@ func TestFlattenNotificationChannel(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenNotificationChannel_PreservesSecretProperties(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+	d := r.TestResourceData()
+
+	configured := []interface{}{
+		map[string]interface{}{"key": "token", "value": "secret-token", "label": "", "display_value": ""},
+		map[string]interface{}{"key": "channel", "value": "alerts", "label": "", "display_value": ""},
+	}
+	err := d.Set("property", configured)
+	assert.NoError(t, err)
+
+	err = flattenNotificationChannel(&notifications.AiNotificationsChannel{
+		Name: "slack",
+		Type: "SLACK",
+		Properties: []notifications.AiNotificationsProperty{
+			{Key: "token", Value: "********"},
+			{Key: "channel", Value: "alerts"},
+		},
+	}, d)
+	assert.NoError(t, err)
+
+	// The property set read back hashes to the configured set, so re-applying shows no diff
+	properties := d.Get("property").(*schema.Set)
+	expected := schema.NewSet(properties.F, configured)
+	assert.True(t, expected.Equal(properties))
+}
+
+func TestFlattenNotificationChannel_Import(t *testing.T) {
+	d := resourceNewRelicNotificationChannel().TestResourceData()
+	d.SetId("12345:b1e90a32-23b7-4028-b2c7-ffbdfe103852")
+
+	imported, err := resourceImportStateWithAccountID()(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Len(t, imported, 1)
+
+	d = imported[0]
+	assert.Equal(t, "b1e90a32-23b7-4028-b2c7-ffbdfe103852", d.Id())
+
+	err = flattenNotificationChannel(&notifications.AiNotificationsChannel{
+		AccountID:     12345,
+		Name:          "webhook",
+		Type:          "WEBHOOK",
+		Product:       "IINT",
+		DestinationId: "0f7ad6c3-7a7d-4c34-9b4e-1d6a1b3c9e11",
+		Properties: []notifications.AiNotificationsProperty{
+			{Key: "payload", Value: "{\"id\": \"test\"}", Label: "Payload Template"},
+			{Key: "url", Value: "https://webhook.site/"},
+		},
+	}, d)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 12345, d.Get("account_id"))
+	assert.Equal(t, "WEBHOOK", d.Get("type"))
+	assert.Equal(t, "IINT", d.Get("product"))
+	assert.Equal(t, "0f7ad6c3-7a7d-4c34-9b4e-1d6a1b3c9e11", d.Get("destination_id"))
+
+	// Without prior state every property is read back as returned by the API
+	properties := d.Get("property").(*schema.Set)
+	expected := schema.NewSet(properties.F, []interface{}{
+		map[string]interface{}{"key": "payload", "value": "{\"id\": \"test\"}", "label": "Payload Template", "display_value": ""},
+		map[string]interface{}{"key": "url", "value": "https://webhook.site/", "label": "", "display_value": ""},
+	})
+	assert.True(t, expected.Equal(properties))
+}
+
+func TestNotificationPropertyValueDiffSuppress(t *testing.T) {
+	valueSchema := notificationsPropertySchema().Schema["value"]
+
+	assert.True(t, valueSchema.DiffSuppressFunc("property.0.value", "********", "secret-token", nil))
+	assert.True(t, valueSchema.DiffSuppressFunc("property.0.value", "terraform", "", nil))
+	assert.False(t, valueSchema.DiffSuppressFunc("property.0.value", "old", "new", nil))
+	assert.False(t, valueSchema.DiffSuppressFunc("property.0.value", "", "new", nil))
+}
+
+func TestValidateNotificationChannelProduct(t *testing.T) {
+	cases := []struct {
+		channelType string
+		product     string
+		err         string
+	}{
+		{channelType: "WEBHOOK", product: "IINT"},
+		{channelType: "EMAIL", product: "DISCUSSIONS"},
+		{channelType: "JIRA_CLASSIC", product: "ERROR_TRACKING"},
+		{channelType: "SLACK", product: "IINT"},
+		{channelType: "SLACK_COLLABORATION", product: "DISCUSSIONS"},
+		{channelType: "WEBHOOK", product: "ERROR_TRACKING", err: `product "ERROR_TRACKING" is not supported by channel type "WEBHOOK", must be one of: IINT`},
+		{channelType: "PAGERDUTY_SERVICE_INTEGRATION", product: "DISCUSSIONS", err: `product "DISCUSSIONS" is not supported by channel type "PAGERDUTY_SERVICE_INTEGRATION", must be one of: IINT`},
+		{channelType: "SLACK_COLLABORATION", product: "IINT", err: `product "IINT" is not supported by channel type "SLACK_COLLABORATION", must be one of: DISCUSSIONS, ERROR_TRACKING`},
+		{channelType: "EMAIL", product: "ERROR_TRACKING", err: `product "ERROR_TRACKING" is not supported by channel type "EMAIL", must be one of: IINT, DISCUSSIONS`},
+	}
+
+	for _, c := range cases {
+		err := validateNotificationChannelProduct(c.channelType, c.product)
+		if c.err == "" {
+			assert.NoError(t, err, c.channelType+"/"+c.product)
+		} else {
+			assert.EqualError(t, err, c.err)
+		}
+	}
+}
+
+func TestNotificationChannelProductsByType_CoversEveryType(t *testing.T) {
+	products := notificationChannelProductsByType()
+
+	for _, channelType := range listValidNotificationsChannelTypes() {
+		assert.NotEmpty(t, products[channelType], channelType)
+		for _, product := range products[channelType] {
+			assert.Contains(t, listValidNotificationsProductTypes(), product, channelType)
+		}
+	}
+}
@@ -70,7 +70,8 @@ func expandNotificationDestinationUpdate(d *schema.ResourceData) (*notifications
 		Active: d.Get("active").(bool),
 	}
 
-	if attr, ok := d.GetOk("auth_basic"); ok {
+	// Basic credentials are only sent when they change, so an unchanged password isn't re-sent
+	if attr, ok := d.GetOk("auth_basic"); ok && d.HasChange("auth_basic") {
 		destination.Auth = expandNotificationDestinationAuthBasic(attr.([]interface{}))
 	}
 
@@ -150,7 +151,8 @@ func flattenNotificationDestination(destination *notifications.AiNotificationsDe
 		}
 	}
 
-	if err := d.Set("property", flattenNotificationDestinationProperties(destination.Properties)); err != nil {
+	priorProperties := d.Get("property").(*schema.Set).List()
+	if err := d.Set("property", preserveNotificationSecretProperties(flattenNotificationDestinationProperties(destination.Properties), priorProperties)); err != nil {
 		return err
 	}
 
@@ -248,5 +250,9 @@ func flattenNotificationDestinationDataSource(destination *notifications.AiNotif
 		return err
 	}
 
+	if err := d.Set("last_sent", destination.LastSent); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -4,8 +4,11 @@
 package newrelic
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
@@ -155,6 +158,43 @@ func TestFlattenNotificationDestination(t *testing.T) {
 	}
 }
 
+func TestFlattenNotificationDestination_PreservesSecretProperties(t *testing.T) {
+	d := resourceNewRelicNotificationDestination().TestResourceData()
+
+	configured := []interface{}{
+		map[string]interface{}{"key": "token", "value": "secret-token", "label": "", "display_value": ""},
+		map[string]interface{}{"key": "password", "value": "secret-password", "label": "", "display_value": ""},
+		map[string]interface{}{"key": "routingKey", "value": "routing-key", "label": "Integration API Key", "display_value": ""},
+		map[string]interface{}{"key": "url", "value": "https://example.com/old", "label": "", "display_value": ""},
+	}
+	assert.NoError(t, d.Set("property", configured))
+
+	destination := &notifications.AiNotificationsDestination{
+		Name: "webhook",
+		Type: "WEBHOOK",
+		Properties: []notifications.AiNotificationsProperty{
+			{Key: "token", Value: "********"},
+			{Key: "password", Value: ""},
+			{Key: "routingKey", Value: "new-routing-key", Label: "Integration API Key"},
+			{Key: "url", Value: "https://example.com/new"},
+		},
+	}
+
+	// Secret values are kept across reads, other values are read back from the API
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, flattenNotificationDestination(destination, d))
+
+		properties := d.Get("property").(*schema.Set)
+		expected := schema.NewSet(properties.F, []interface{}{
+			map[string]interface{}{"key": "token", "value": "secret-token", "label": "", "display_value": ""},
+			map[string]interface{}{"key": "password", "value": "secret-password", "label": "", "display_value": ""},
+			map[string]interface{}{"key": "routingKey", "value": "new-routing-key", "label": "Integration API Key", "display_value": ""},
+			map[string]interface{}{"key": "url", "value": "https://example.com/new", "label": "", "display_value": ""},
+		})
+		assert.True(t, expected.Equal(properties))
+	}
+}
+
 func TestFlattenNotificationDestinationDataSource(t *testing.T) {
 	r := dataSourceNewRelicNotificationDestination()
 
@@ -205,3 +245,79 @@ func testFlattenNotificationDestinationAuth(t *testing.T, v interface{}, auth ai
 		}
 	}
 }
+
+// testNotificationDestinationAuthBasicUpdateData returns resource data planned to move a destination
+// with the given password in state to the configured one.
+func testNotificationDestinationAuthBasicUpdateData(t *testing.T, statePassword string, configPassword string) *schema.ResourceData {
+	r := resourceNewRelicNotificationDestination()
+
+	prior := r.TestResourceData()
+	prior.SetId("7463c367-6d61-416b-9aac-47f4a285fe5a")
+	assert.NoError(t, prior.Set("account_id", 1))
+	assert.NoError(t, prior.Set("name", "webhook"))
+	assert.NoError(t, prior.Set("type", "WEBHOOK"))
+	assert.NoError(t, prior.Set("active", true))
+	assert.NoError(t, prior.Set("auth_basic", []interface{}{
+		map[string]interface{}{"user": "username", "password": statePassword},
+	}))
+	assert.NoError(t, prior.Set("property", []interface{}{
+		map[string]interface{}{"key": "url", "value": "https://webhook.site/"},
+	}))
+	state := prior.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": 1,
+		"name":       "webhook",
+		"type":       "WEBHOOK",
+		"auth_basic": []interface{}{
+			map[string]interface{}{"user": "username", "password": configPassword},
+		},
+		"property": []interface{}{
+			map[string]interface{}{"key": "url", "value": "https://webhook.site/"},
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	assert.False(t, d.HasChange("property"))
+
+	return d
+}
+
+func TestExpandNotificationDestinationUpdate_AuthBasicRotation(t *testing.T) {
+	d := testNotificationDestinationAuthBasicUpdateData(t, "old-password", "new-password")
+	assert.True(t, d.HasChange("auth_basic"))
+
+	destination, err := expandNotificationDestinationUpdate(d)
+	assert.NoError(t, err)
+	assert.NotNil(t, destination.Auth)
+	assert.Equal(t, "username", destination.Auth.Basic.User)
+	assert.Equal(t, notifications.SecureValue("new-password"), destination.Auth.Basic.Password)
+}
+
+func TestExpandNotificationDestinationUpdate_AuthBasicUnchanged(t *testing.T) {
+	for name, configPassword := range map[string]string{"same password": "old-password", "empty password": ""} {
+		t.Run(name, func(t *testing.T) {
+			d := testNotificationDestinationAuthBasicUpdateData(t, "old-password", configPassword)
+			assert.False(t, d.HasChange("auth_basic"))
+			assert.Equal(t, "old-password", d.Get("auth_basic.0.password"))
+
+			destination, err := expandNotificationDestinationUpdate(d)
+			assert.NoError(t, err)
+			assert.Nil(t, destination.Auth)
+		})
+	}
+}
+
+func TestValidateNotificationDestinationAuthBasic(t *testing.T) {
+	assert.NoError(t, validateNotificationDestinationAuthBasic([]interface{}{
+		map[string]interface{}{"user": "username", "password": "password"},
+	}))
+	assert.EqualError(t,
+		validateNotificationDestinationAuthBasic([]interface{}{map[string]interface{}{"user": "username", "password": ""}}),
+		"auth_basic.0.password is required when creating a notification destination",
+	)
+}
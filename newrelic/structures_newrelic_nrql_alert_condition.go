@@ -321,35 +321,21 @@ func expandNrqlTerms(d *schema.ResourceData, conditionType string) ([]alerts.Nrq
 	}
 
 	if len(expandedTerms) == 0 {
-		if critical, ok := d.GetOk("critical"); ok {
-			x := critical.([]interface{})
-			// A critical attribute is a list, but is limited to a single item in the schema.
-			if len(x) > 0 {
-				single := x[0].(map[string]interface{})
-
-				criticalTerm, err := expandNrqlConditionTerm(single, conditionType, "critical")
-				if err != nil {
-					return nil, err
+		// The named priorities are lists of terms, which are sent in order with the critical terms first.
+		for _, priority := range []string{"critical", "warning"} {
+			for _, t := range d.Get(priority).([]interface{}) {
+				term, ok := t.(map[string]interface{})
+				if !ok {
+					continue
 				}
-				if criticalTerm != nil {
-					expandedTerms = append(expandedTerms, *criticalTerm)
-				}
-			}
-		}
 
-		if warning, ok := d.GetOk("warning"); ok {
-			x := warning.([]interface{})
-			// A warning attribute is a list, but is limited to a single item in the schema.
-			if len(x) > 0 {
-				single := x[0].(map[string]interface{})
-
-				warningTerm, err := expandNrqlConditionTerm(single, conditionType, "warning")
+				nrqlConditionTerm, err := expandNrqlConditionTerm(term, conditionType, priority)
 				if err != nil {
 					return nil, err
 				}
 
-				if warningTerm != nil {
-					expandedTerms = append(expandedTerms, *warningTerm)
+				if nrqlConditionTerm != nil {
+					expandedTerms = append(expandedTerms, *nrqlConditionTerm)
 				}
 			}
 		}
@@ -502,7 +488,7 @@ func flattenNrqlAlertCondition(accountID int, condition *alerts.NrqlAlertConditi
 	_ = d.Set("enabled", condition.Enabled)
 	_ = d.Set("entity_guid", condition.EntityGUID)
 
-	if conditionType == "baseline" {
+	if conditionType == "baseline" && condition.BaselineDirection != nil {
 		_ = d.Set("baseline_direction", string(*condition.BaselineDirection))
 	}
 
@@ -521,25 +507,19 @@ func flattenNrqlAlertCondition(accountID int, condition *alerts.NrqlAlertConditi
 			return fmt.Errorf("[DEBUG] Error setting nrql alert condition `term`: %v", err)
 		}
 	} else {
-		// Handle the named condition priorities.
+		// Handle the named condition priorities, keeping the order of the terms returned by the API.
+		namedTerms := map[string][]map[string]interface{}{}
 
 		for _, term := range conditionTerms {
-			switch term["priority"].(string) {
-			case "critical":
-				t := term
-				delete(t, "priority")
-				var terms []map[string]interface{}
-				terms = append(terms, t)
-				if err := d.Set("critical", terms); err != nil {
-					return fmt.Errorf("[DEBUG] Error setting nrql alert condition `critical`: %v", err)
-				}
-			case "warning":
-				t := term
-				delete(t, "priority")
-				var terms []map[string]interface{}
-				terms = append(terms, t)
-				if err := d.Set("warning", terms); err != nil {
-					return fmt.Errorf("[DEBUG] Error setting nrql alert condition `warning`: %v", err)
+			priority := term["priority"].(string)
+			delete(term, "priority")
+			namedTerms[priority] = append(namedTerms[priority], term)
+		}
+
+		for _, priority := range []string{"critical", "warning"} {
+			if terms, ok := namedTerms[priority]; ok {
+				if err := d.Set(priority, terms); err != nil {
+					return fmt.Errorf("[DEBUG] Error setting nrql alert condition `%s`: %v", priority, err)
 				}
 			}
 		}
@@ -135,6 +135,47 @@ func TestExpandNrqlAlertConditionInput(t *testing.T) {
 				return &x
 			}(),
 		},
+		"two critical terms": {
+			Data: map[string]interface{}{
+				"nrql": []interface{}{nrql},
+				"type": "static",
+				"critical": append(criticalTerms, map[string]interface{}{
+					"threshold":             10.9,
+					"threshold_occurrences": alerts.ThresholdOccurrences.All,
+					"threshold_duration":    1200,
+					"operator":              alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+				}),
+				"warning": warningTerms,
+			},
+			Expanded: func() *alerts.NrqlConditionCreateInput {
+				x := alerts.NrqlConditionCreateInput{}
+				x.Terms = []alerts.NrqlConditionTerm{
+					{
+						Threshold:            &testThresholdLow,
+						ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+						ThresholdDuration:    600,
+						Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+						Priority:             alerts.NrqlConditionPriorities.Critical,
+					},
+					{
+						Threshold:            &testThresholdHigh,
+						ThresholdOccurrences: alerts.ThresholdOccurrences.All,
+						ThresholdDuration:    1200,
+						Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+						Priority:             alerts.NrqlConditionPriorities.Critical,
+					},
+					{
+						Threshold:            &testThresholdHigh,
+						ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+						ThresholdDuration:    660,
+						Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.BELOW,
+						Priority:             alerts.NrqlConditionPriorities.Warning,
+					},
+				}
+
+				return &x
+			}(),
+		},
 		"aggregation window non-zero": {
 			Data: map[string]interface{}{
 				"nrql":               []interface{}{nrql},
@@ -571,6 +612,101 @@ func TestFlattenNrqlAlertCondition(t *testing.T) {
 	}
 }
 
+func TestFlattenNrqlAlertCondition_MultipleCriticalTerms(t *testing.T) {
+	r := resourceNewRelicNrqlAlertCondition()
+	d := r.TestResourceData()
+
+	condition := &alerts.NrqlAlertCondition{
+		ID:       "1234567",
+		PolicyID: "7654321",
+		NrqlConditionBase: alerts.NrqlConditionBase{
+			Name: "name-test",
+			Type: alerts.NrqlConditionTypes.Static,
+			Terms: []alerts.NrqlConditionTerm{
+				{
+					Threshold:            &testThresholdHigh,
+					ThresholdOccurrences: alerts.ThresholdOccurrences.All,
+					ThresholdDuration:    120,
+					Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+					Priority:             alerts.NrqlConditionPriorities.Critical,
+				},
+				{
+					Threshold:            &testThresholdLow,
+					ThresholdOccurrences: alerts.ThresholdOccurrences.All,
+					ThresholdDuration:    600,
+					Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+					Priority:             alerts.NrqlConditionPriorities.Critical,
+				},
+			},
+		},
+	}
+
+	err := flattenNrqlAlertCondition(1, condition, d)
+	require.NoError(t, err)
+
+	criticalTerms := d.Get("critical").([]interface{})
+	require.Equal(t, 2, len(criticalTerms))
+	assert.Equal(t, 120, criticalTerms[0].(map[string]interface{})["threshold_duration"])
+	assert.Equal(t, float64(10.9), criticalTerms[0].(map[string]interface{})["threshold"])
+	assert.Equal(t, 600, criticalTerms[1].(map[string]interface{})["threshold_duration"])
+	assert.Equal(t, float64(1), criticalTerms[1].(map[string]interface{})["threshold"])
+	assert.Empty(t, d.Get("warning").([]interface{}))
+}
+
+func TestNrqlAlertConditionRunbookURLAndDescriptionRoundTrip(t *testing.T) {
+	r := resourceNewRelicNrqlAlertCondition()
+	d := r.TestResourceData()
+	require.NoError(t, d.Set("name", "name-test"))
+	require.NoError(t, d.Set("type", "static"))
+	require.NoError(t, d.Set("runbook_url", "https://runbooks.example.com/high-error-rate"))
+	require.NoError(t, d.Set("description", "Error rate is above the expected range"))
+	require.NoError(t, d.Set("nrql", []interface{}{map[string]interface{}{"query": "SELECT count(*) FROM TransactionError"}}))
+	require.NoError(t, d.Set("critical", []interface{}{map[string]interface{}{
+		"threshold":             1.0,
+		"threshold_duration":    120,
+		"threshold_occurrences": "all",
+		"operator":              "above",
+	}}))
+
+	createInput, err := expandNrqlAlertConditionCreateInput(d)
+	require.NoError(t, err)
+	assert.Equal(t, "https://runbooks.example.com/high-error-rate", createInput.RunbookURL)
+	assert.Equal(t, "Error rate is above the expected range", createInput.Description)
+
+	updateInput, err := expandNrqlAlertConditionUpdateInput(d)
+	require.NoError(t, err)
+	assert.Equal(t, createInput.RunbookURL, updateInput.RunbookURL)
+	assert.Equal(t, createInput.Description, updateInput.Description)
+
+	condition := &alerts.NrqlAlertCondition{
+		ID:       "1234567",
+		PolicyID: "7654321",
+		NrqlConditionBase: alerts.NrqlConditionBase{
+			Name:        "name-test",
+			Type:        alerts.NrqlConditionTypes.Static,
+			RunbookURL:  createInput.RunbookURL,
+			Description: createInput.Description,
+		},
+	}
+
+	flattened := r.TestResourceData()
+	require.NoError(t, flattenNrqlAlertCondition(1, condition, flattened))
+	assert.Equal(t, "https://runbooks.example.com/high-error-rate", flattened.Get("runbook_url"))
+	assert.Equal(t, "Error rate is above the expected range", flattened.Get("description"))
+}
+
+func TestValidateNrqlAlertConditionRunbookURL(t *testing.T) {
+	validateFunc := resourceNewRelicNrqlAlertCondition().Schema["runbook_url"].ValidateFunc
+
+	_, errs := validateFunc("https://runbooks.example.com/high-error-rate", "runbook_url")
+	assert.Empty(t, errs)
+
+	for _, invalid := range []string{"not a url", "runbooks.example.com", "ftp://runbooks.example.com"} {
+		_, errs = validateFunc(invalid, "runbook_url")
+		assert.NotEmpty(t, errs, invalid)
+	}
+}
+
 func TestExpandNrqlConditionTerm(t *testing.T) {
 
 	cases := map[string]struct {
@@ -703,3 +839,39 @@ func TestExpandNrqlConditionTerm(t *testing.T) {
 	}
 
 }
+
+func TestNrqlAlertConditionExpirationRoundTrip(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"open only": {
+			"expiration_duration":          120,
+			"open_violation_on_expiration": true,
+		},
+		"close only": {
+			"expiration_duration":            300,
+			"close_violations_on_expiration": true,
+		},
+		"duration only": {
+			"expiration_duration": 600,
+		},
+		"no expiration": {},
+	}
+
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			configured := resourceNewRelicNrqlAlertCondition().TestResourceData()
+			for k, v := range data {
+				require.NoError(t, configured.Set(k, v))
+			}
+
+			expiration, err := expandExpiration(configured)
+			require.NoError(t, err)
+
+			read := resourceNewRelicNrqlAlertCondition().TestResourceData()
+			require.NoError(t, flattenExpiration(read, expiration))
+
+			for _, attr := range []string{"expiration_duration", "open_violation_on_expiration", "close_violations_on_expiration"} {
+				require.Equal(t, configured.Get(attr), read.Get(attr), attr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -491,7 +492,7 @@ func expandDashboardWidgetInput(w map[string]interface{}, meta interface{}, visu
 		cfg.Facet = &l
 	}
 
-	if visualisation != "viz.line" {
+	if visualisation != "viz.line" && visualisation != "viz.area" {
 		if q, ok := w["y_axis_left_min"]; ok {
 			var l dashboards.DashboardWidgetYAxisLeft
 			min := q.(float64)
@@ -723,17 +724,16 @@ func flattenDashboardEntity(dashboard *entities.DashboardEntity, d *schema.Resou
 	}
 
 	if dashboard.Pages != nil && len(dashboard.Pages) > 0 {
-		pages := flattenDashboardPage(&dashboard.Pages)
+		pages := flattenDashboardPage(&dashboard.Pages, d.Get("page").([]interface{}))
 		if err := d.Set("page", pages); err != nil {
 			return err
 		}
 	}
 
-	if dashboard.Variables != nil && len(dashboard.Variables) > 0 {
-		variables := flattenDashboardVariable(&dashboard.Variables)
-		if err := d.Set("variable", variables); err != nil {
-			return err
-		}
+	// Always set the variables so that variables removed outside of Terraform are detected.
+	variables := flattenDashboardVariable(&dashboard.Variables)
+	if err := d.Set("variable", variables); err != nil {
+		return err
 	}
 
 	return nil
@@ -760,7 +760,7 @@ func flattenDashboardUpdateResult(result *dashboards.DashboardUpdateResult, d *s
 	}
 
 	if dashboard.Pages != nil && len(dashboard.Pages) > 0 {
-		pages := flattenDashboardPage(&dashboard.Pages)
+		pages := flattenDashboardPage(&dashboard.Pages, d.Get("page").([]interface{}))
 		if err := d.Set("page", pages); err != nil {
 			return err
 		}
@@ -836,11 +836,16 @@ func flattenVariableNRQLQuery(in *entities.DashboardVariableNRQLQuery) []interfa
 }
 
 // return []interface{} because Page is a SetList
-func flattenDashboardPage(in *[]entities.DashboardPage) []interface{} {
+//
+// priorPages holds the pages currently in state. The widgets of a page are ordered to match
+// the widgets of the prior page with the same name, so a dashboard that has been reordered
+// remotely does not produce a diff. On import there is no prior state and the API order is kept.
+func flattenDashboardPage(in *[]entities.DashboardPage, priorPages []interface{}) []interface{} {
 	out := make([]interface{}, len(*in))
 
 	for i, p := range *in {
 		m := make(map[string]interface{})
+		prior := findDashboardPriorPage(priorPages, p.Name, i)
 
 		m["guid"] = p.GUID
 		m["name"] = p.Name
@@ -861,12 +866,89 @@ func flattenDashboardPage(in *[]entities.DashboardPage) []interface{} {
 			}
 		}
 
+		if prior != nil {
+			for widgetType, widgets := range m {
+				if priorWidgets, ok := prior[widgetType].([]interface{}); ok && strings.HasPrefix(widgetType, "widget_") {
+					m[widgetType] = orderDashboardWidgets(widgets.([]interface{}), priorWidgets)
+				}
+			}
+		}
+
 		out[i] = m
 	}
 
 	return out
 }
 
+// findDashboardPriorPage returns the prior page with the given name, falling back to the
+// page at the same position when no page has that name (e.g. the page was renamed).
+func findDashboardPriorPage(priorPages []interface{}, name string, index int) map[string]interface{} {
+	for _, p := range priorPages {
+		if page, ok := p.(map[string]interface{}); ok && page["name"] == name {
+			return page
+		}
+	}
+
+	if index < len(priorPages) {
+		if page, ok := priorPages[index].(map[string]interface{}); ok {
+			return page
+		}
+	}
+
+	return nil
+}
+
+// orderDashboardWidgets orders the widgets read from the API like the prior widgets of the
+// same type. Widgets are matched by their position on the page, then by title. Widgets
+// without a match are appended ordered by row and column.
+func orderDashboardWidgets(widgets []interface{}, priorWidgets []interface{}) []interface{} {
+	ordered := make([]interface{}, 0, len(widgets))
+	used := make([]bool, len(widgets))
+
+	match := func(same func(w, p map[string]interface{}) bool, p map[string]interface{}) bool {
+		for i, w := range widgets {
+			if !used[i] && same(w.(map[string]interface{}), p) {
+				used[i] = true
+				ordered = append(ordered, w)
+				return true
+			}
+		}
+		return false
+	}
+	samePosition := func(w, p map[string]interface{}) bool {
+		return w["row"] == p["row"] && w["column"] == p["column"]
+	}
+	sameTitle := func(w, p map[string]interface{}) bool {
+		return p["title"] != nil && p["title"] != "" && w["title"] == p["title"]
+	}
+
+	for _, pw := range priorWidgets {
+		p, ok := pw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !match(samePosition, p) {
+			match(sameTitle, p)
+		}
+	}
+
+	var unmatched []interface{}
+	for i, w := range widgets {
+		if !used[i] {
+			unmatched = append(unmatched, w)
+		}
+	}
+	sort.SliceStable(unmatched, func(i, j int) bool {
+		a, b := unmatched[i].(map[string]interface{}), unmatched[j].(map[string]interface{})
+		if a["row"] != b["row"] {
+			return a["row"].(int) < b["row"].(int)
+		}
+		return a["column"].(int) < b["column"].(int)
+	})
+
+	return append(ordered, unmatched...)
+}
+
 func flattenLinkedEntityGUIDs(linkedEntities []entities.EntityOutlineInterface) []string {
 	out := make([]string, len(linkedEntities))
 
@@ -922,7 +1004,9 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 		out["facet_show_other_series"] = rawCfg.Facet.ShowOtherSeries
 	}
 	if rawCfg.YAxisLeft != nil {
-		out["y_axis_left_min"] = rawCfg.YAxisLeft.Min
+		if rawCfg.YAxisLeft.Min != nil {
+			out["y_axis_left_min"] = *rawCfg.YAxisLeft.Min
+		}
 		out["y_axis_left_max"] = rawCfg.YAxisLeft.Max
 	}
 	if rawCfg.NullValues != nil {
@@ -940,6 +1024,9 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 	case "viz.area":
 		widgetType = "widget_area"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+		if rawCfg.YAxisLeft != nil && rawCfg.YAxisLeft.Zero != nil {
+			out["y_axis_left_zero"] = *rawCfg.YAxisLeft.Zero
+		}
 	case "viz.bar":
 		widgetType = "widget_bar"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
@@ -981,8 +1068,8 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 	case "viz.line":
 		widgetType = "widget_line"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
-		if rawCfg.YAxisLeft != nil {
-			out["y_axis_left_zero"] = rawCfg.YAxisLeft.Zero
+		if rawCfg.YAxisLeft != nil && rawCfg.YAxisLeft.Zero != nil {
+			out["y_axis_left_zero"] = *rawCfg.YAxisLeft.Zero
 		}
 	case "viz.markdown":
 		widgetType = "widget_markdown"
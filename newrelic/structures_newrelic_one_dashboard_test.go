@@ -1,9 +1,14 @@
 package newrelic
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -89,3 +94,474 @@ func TestExpandDashboardBillboardThresholdNullValue(t *testing.T) {
 	assert.NotContains(t, out, "warning")
 	assert.Equal(t, out["critical"], "2")
 }
+
+func TestDashboardWidgetAxisOptionsRoundTrip(t *testing.T) {
+	for _, viz := range []string{"viz.area", "viz.line"} {
+		w := map[string]interface{}{
+			"title":            "axis options",
+			"legend_enabled":   false,
+			"y_axis_left_min":  float64(10),
+			"y_axis_left_max":  float64(100),
+			"y_axis_left_zero": false,
+		}
+
+		widget, cfg, err := expandDashboardWidgetInput(w, nil, viz)
+		assert.NoError(t, err)
+
+		rawConfiguration, err := json.Marshal(cfg)
+		assert.NoError(t, err)
+
+		_, out := flattenDashboardWidget(&entities.DashboardWidget{
+			ID:               "abcde",
+			Title:            widget.Title,
+			Visualization:    entities.DashboardWidgetVisualization{ID: viz},
+			RawConfiguration: rawConfiguration,
+		}, "abcde")
+
+		assert.Equal(t, false, out["legend_enabled"], viz)
+		assert.Equal(t, float64(10), out["y_axis_left_min"], viz)
+		assert.Equal(t, float64(100), out["y_axis_left_max"], viz)
+		assert.Equal(t, false, out["y_axis_left_zero"], viz)
+	}
+}
+
+func TestDashboardWidgetNullValuesRoundTrip(t *testing.T) {
+	nullValues := []interface{}{
+		map[string]interface{}{
+			"null_value": "zero",
+			"series_overrides": []interface{}{
+				map[string]interface{}{"null_value": "remove", "series_name": "first series"},
+				map[string]interface{}{"null_value": "preserve", "series_name": "second series"},
+			},
+		},
+	}
+
+	for _, viz := range []string{"viz.area", "viz.line"} {
+		w := map[string]interface{}{
+			"title":       "null values",
+			"null_values": nullValues,
+		}
+
+		widget, cfg, err := expandDashboardWidgetInput(w, nil, viz)
+		assert.NoError(t, err)
+		assert.NotNil(t, cfg.NullValues, viz)
+
+		rawConfiguration, err := json.Marshal(cfg)
+		assert.NoError(t, err)
+
+		_, out := flattenDashboardWidget(&entities.DashboardWidget{
+			ID:               "abcde",
+			Title:            widget.Title,
+			Visualization:    entities.DashboardWidgetVisualization{ID: viz},
+			RawConfiguration: rawConfiguration,
+		}, "abcde")
+
+		assert.Equal(t, nullValues, out["null_values"], viz)
+	}
+}
+
+// Extrapolation and result limits are NRQL clauses rather than widget options, so they
+// round-trip as part of the query next to the platform options.
+func TestDashboardWidgetQueryOptionsRoundTrip(t *testing.T) {
+	query := "SELECT count(*) FROM Transaction FACET appName LIMIT 20 EXTRAPOLATE TIMESERIES"
+
+	for _, viz := range []string{"viz.bar", "viz.line", "viz.table"} {
+		w := map[string]interface{}{
+			"title":                   "query options",
+			"ignore_time_range":       true,
+			"facet_show_other_series": true,
+			"nrql_query": []interface{}{
+				map[string]interface{}{"account_id": 1, "query": query},
+			},
+		}
+
+		widget, cfg, err := expandDashboardWidgetInput(w, nil, viz)
+		assert.NoError(t, err)
+
+		rawConfiguration, err := json.Marshal(cfg)
+		assert.NoError(t, err)
+
+		_, out := flattenDashboardWidget(&entities.DashboardWidget{
+			ID:               "abcde",
+			Title:            widget.Title,
+			Visualization:    entities.DashboardWidgetVisualization{ID: viz},
+			RawConfiguration: rawConfiguration,
+		}, "abcde")
+
+		assert.Equal(t, true, out["ignore_time_range"], viz)
+		assert.Equal(t, true, out["facet_show_other_series"], viz)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"account_id": 1, "query": nrdb.NRQL(query)},
+		}, out["nrql_query"], viz)
+	}
+}
+
+func TestDashboardWidgetFacetShowOtherSeriesRoundTrip(t *testing.T) {
+	for _, viz := range []string{"viz.area", "viz.bar", "viz.line", "viz.pie", "viz.stacked-bar", "viz.table"} {
+		for _, showOtherSeries := range []bool{true, false} {
+			w := map[string]interface{}{
+				"title":                   "other series",
+				"facet_show_other_series": showOtherSeries,
+				"nrql_query": []interface{}{
+					map[string]interface{}{"account_id": 1, "query": "SELECT count(*) FROM Transaction FACET appName"},
+				},
+			}
+
+			widget, cfg, err := expandDashboardWidgetInput(w, nil, viz)
+			assert.NoError(t, err)
+			assert.NotNil(t, cfg.Facet, viz)
+			assert.Equal(t, showOtherSeries, cfg.Facet.ShowOtherSeries, viz)
+
+			rawConfiguration, err := json.Marshal(cfg)
+			assert.NoError(t, err)
+
+			_, out := flattenDashboardWidget(&entities.DashboardWidget{
+				ID:               "abcde",
+				Title:            widget.Title,
+				Visualization:    entities.DashboardWidgetVisualization{ID: viz},
+				RawConfiguration: rawConfiguration,
+			}, "abcde")
+
+			assert.Equal(t, showOtherSeries, out["facet_show_other_series"], viz)
+		}
+	}
+}
+
+// A fixed time range is set with SINCE and UNTIL in the query, and ignore_time_range makes it
+// override the dashboard time picker.
+func TestDashboardWidgetFixedTimeRangeRoundTrip(t *testing.T) {
+	query := "SELECT count(*) FROM Transaction SINCE '2023-01-01 00:00:00' UNTIL '2023-02-01 00:00:00'"
+
+	for _, viz := range []string{"viz.area", "viz.billboard", "viz.line", "viz.pie", "viz.table"} {
+		for _, ignoreTimeRange := range []bool{true, false} {
+			w := map[string]interface{}{
+				"title":             "fixed time range",
+				"ignore_time_range": ignoreTimeRange,
+				"nrql_query": []interface{}{
+					map[string]interface{}{"account_id": 1, "query": query},
+				},
+			}
+
+			widget, cfg, err := expandDashboardWidgetInput(w, nil, viz)
+			assert.NoError(t, err)
+			assert.NotNil(t, cfg.PlatformOptions, viz)
+			assert.Equal(t, ignoreTimeRange, cfg.PlatformOptions.IgnoreTimeRange, viz)
+
+			rawConfiguration, err := json.Marshal(cfg)
+			assert.NoError(t, err)
+
+			_, out := flattenDashboardWidget(&entities.DashboardWidget{
+				ID:               "abcde",
+				Title:            widget.Title,
+				Visualization:    entities.DashboardWidgetVisualization{ID: viz},
+				RawConfiguration: rawConfiguration,
+			}, "abcde")
+
+			assert.Equal(t, ignoreTimeRange, out["ignore_time_range"], viz)
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"account_id": 1, "query": nrdb.NRQL(query)},
+			}, out["nrql_query"], viz)
+		}
+	}
+}
+
+func TestFlattenDashboardPage_PriorWidgetOrder(t *testing.T) {
+	pages := []entities.DashboardPage{
+		{
+			Name: "Page 2",
+			Widgets: []entities.DashboardWidget{
+				{
+					Title:         "upper",
+					Layout:        entities.DashboardWidgetLayout{Row: 1, Column: 1},
+					Visualization: entities.DashboardWidgetVisualization{ID: "viz.bar"},
+				},
+				{
+					Title:         "lower",
+					Layout:        entities.DashboardWidgetLayout{Row: 4, Column: 1},
+					Visualization: entities.DashboardWidgetVisualization{ID: "viz.bar"},
+				},
+				{
+					Title:         "new",
+					Layout:        entities.DashboardWidgetLayout{Row: 7, Column: 1},
+					Visualization: entities.DashboardWidgetVisualization{ID: "viz.bar"},
+				},
+			},
+		},
+	}
+
+	titles := func(page interface{}) []interface{} {
+		var out []interface{}
+		for _, w := range page.(map[string]interface{})["widget_bar"].([]interface{}) {
+			out = append(out, w.(map[string]interface{})["title"])
+		}
+		return out
+	}
+
+	// Without prior state (import) the API order is kept
+	imported := flattenDashboardPage(&pages, nil)
+	assert.Equal(t, []interface{}{"upper", "lower", "new"}, titles(imported[0]))
+
+	// With prior state the widgets follow the prior order, matched by position or title
+	prior := []interface{}{
+		map[string]interface{}{
+			"name": "Page 2",
+			"widget_bar": []interface{}{
+				map[string]interface{}{"title": "lower", "row": 4, "column": 1},
+				map[string]interface{}{"title": "upper", "row": 2, "column": 1},
+			},
+		},
+	}
+	read := flattenDashboardPage(&pages, prior)
+	assert.Equal(t, []interface{}{"lower", "upper", "new"}, titles(read[0]))
+}
+
+func TestDashboardVariableEnumItemsRoundTrip(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"title": "first item", "value": "FIRST"},
+		map[string]interface{}{"title": "second item", "value": "SECOND"},
+		map[string]interface{}{"title": "", "value": "THIRD"},
+	}
+
+	expanded := expandDashboardVariablesInput([]interface{}{
+		map[string]interface{}{
+			"name":  "variable",
+			"title": "title",
+			"type":  "enum",
+			"item":  items,
+		},
+	})
+	assert.Len(t, expanded, 1)
+	assert.Equal(t, "ENUM", string(expanded[0].Type))
+	assert.Len(t, expanded[0].Items, 3)
+
+	// Simulate the API returning the items that were sent
+	apiItems := make([]entities.DashboardVariableEnumItem, len(expanded[0].Items))
+	for i, item := range expanded[0].Items {
+		apiItems[i] = entities.DashboardVariableEnumItem{Title: item.Title, Value: item.Value}
+	}
+
+	variables := flattenDashboardVariable(&[]entities.DashboardVariable{
+		{
+			Name:  "variable",
+			Title: "title",
+			Type:  entities.DashboardVariableTypeTypes.ENUM,
+			Items: apiItems,
+		},
+	})
+	assert.Len(t, variables, 1)
+	assert.Equal(t, items, variables[0].(map[string]interface{})["item"])
+}
+
+func TestValidateDashboardPagesHaveWidgets(t *testing.T) {
+	validPage := map[string]interface{}{
+		"name":          "valid page",
+		"widget_area":   []interface{}{},
+		"widget_line":   []interface{}{map[string]interface{}{"title": "line widget"}},
+		"widget_bullet": []interface{}{},
+	}
+	emptyPage := map[string]interface{}{
+		"name":        "empty page",
+		"description": "a page without widgets",
+		"widget_area": []interface{}{},
+		"widget_line": []interface{}{},
+	}
+
+	assert.NoError(t, validateDashboardPagesHaveWidgets([]interface{}{validPage}))
+	assert.EqualError(t,
+		validateDashboardPagesHaveWidgets([]interface{}{validPage, emptyPage}),
+		`page "empty page" (page.1) must contain at least one widget`,
+	)
+}
+
+func TestValidateDashboardThresholdValue(t *testing.T) {
+	for _, value := range []string{"", "10", "-2.5", "1e3"} {
+		_, errs := validateDashboardThresholdValue(value, "critical")
+		assert.Empty(t, errs, value)
+	}
+
+	_, errs := validateDashboardThresholdValue("high", "warning")
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], `expected warning to be a number, got "high"`)
+}
+
+func TestValidateDashboardBillboardThresholds(t *testing.T) {
+	page := func(critical string, warning string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "billboards",
+			"widget_billboard": []interface{}{
+				map[string]interface{}{"title": "valid", "critical": "2", "warning": "1"},
+				map[string]interface{}{"title": "thresholds", "critical": critical, "warning": warning},
+			},
+		}
+	}
+
+	assert.NoError(t, validateDashboardBillboardThresholds([]interface{}{page("10", "")}))
+	assert.EqualError(t,
+		validateDashboardBillboardThresholds([]interface{}{page("10", "5"), page("ten", "5")}),
+		`expected page.1.widget_billboard.1.critical to be a number, got "ten"`,
+	)
+	assert.EqualError(t,
+		validateDashboardBillboardThresholds([]interface{}{page("10", "5 percent")}),
+		`expected page.0.widget_billboard.1.warning to be a number, got "5 percent"`,
+	)
+}
+
+func TestValidateDashboardWidgetColor(t *testing.T) {
+	for _, value := range []string{"", "#722727", "#fff", "#722727cc", "rgb(114, 39, 39)", "hsl(0, 49%, 30%)"} {
+		_, errs := validateDashboardWidgetColor(value, "color")
+		assert.Empty(t, errs, value)
+	}
+
+	for _, value := range []string{"722727", "#72272", "#72272g", "red"} {
+		_, errs := validateDashboardWidgetColor(value, "color")
+		assert.Len(t, errs, 1, value)
+	}
+}
+
+func TestDashboardWidgetDimensionBounds(t *testing.T) {
+	for name, widgetSchema := range map[string]map[string]*schema.Schema{
+		"one_dashboard":     dashboardWidgetSchemaBase(),
+		"one_dashboard_raw": dashboardRawWidgetSchemaBase(),
+	} {
+		for _, attr := range []string{"width", "height"} {
+			for _, value := range []int{1, 12} {
+				_, errs := widgetSchema[attr].ValidateFunc(value, attr)
+				assert.Empty(t, errs, "%s %s %d", name, attr, value)
+			}
+
+			for _, value := range []int{-1, 0, 13} {
+				_, errs := widgetSchema[attr].ValidateFunc(value, attr)
+				assert.Len(t, errs, 1, "%s %s %d", name, attr, value)
+			}
+		}
+	}
+}
+
+func TestValidateDashboardWidgetColors(t *testing.T) {
+	page := func(overrides ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name": "colors",
+				"widget_line": []interface{}{
+					map[string]interface{}{
+						"title": "colors",
+						"colors": []interface{}{
+							map[string]interface{}{"color": "#722727", "series_overrides": overrides},
+						},
+					},
+				},
+			},
+		}
+	}
+	override := func(color string, seriesName string) interface{} {
+		return map[string]interface{}{"color": color, "series_name": seriesName}
+	}
+
+	assert.NoError(t, validateDashboardWidgetColors(page(override("#722322", "Node"), override("#236f70", "Java"))))
+	assert.EqualError(t,
+		validateDashboardWidgetColors(page(override("#722322", "Node"), override("#72232z", "Java"))),
+		`expected page.0.widget_line.0.colors.0.series_overrides.1.color to be a hex color such as "#722727", or an RGB or HSL color, got "#72232z"`,
+	)
+	assert.EqualError(t,
+		validateDashboardWidgetColors(page(override("#722322", "Node"), override("#236f70", "Node"))),
+		`page.0.widget_line.0.colors.0.series_overrides.1: series "Node" already has a color override`,
+	)
+
+	// With several invalid widgets, the first one in attribute order is always reported
+	invalid := []interface{}{map[string]interface{}{"colors": []interface{}{map[string]interface{}{"color": "red"}}}}
+	pages := []interface{}{map[string]interface{}{
+		"widget_line":  invalid,
+		"widget_area":  invalid,
+		"widget_table": invalid,
+	}}
+	for i := 0; i < 10; i++ {
+		assert.EqualError(t,
+			validateDashboardWidgetColors(pages),
+			`expected page.0.widget_area.0.colors.0.color to be a hex color such as "#722727", or an RGB or HSL color, got "red"`,
+		)
+	}
+}
+
+func TestValidateDashboardWidgetQueries(t *testing.T) {
+	page := func(query string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":            "queries",
+			"widget_markdown": []interface{}{map[string]interface{}{"title": "notes", "text": "notes"}},
+			"widget_line": []interface{}{
+				map[string]interface{}{"title": "valid", "nrql_query": []interface{}{
+					map[string]interface{}{"query": "FROM Transaction SELECT count(*)"},
+				}},
+				map[string]interface{}{"title": "throughput", "nrql_query": []interface{}{
+					map[string]interface{}{"query": "FROM Transaction SELECT rate(count(*), 1 minute)"},
+					map[string]interface{}{"query": query},
+				}},
+			},
+		}
+	}
+
+	known := func(string) bool { return true }
+
+	assert.NoError(t, validateDashboardWidgetQueries([]interface{}{page("FROM Transaction SELECT average(duration)")}, known))
+	assert.EqualError(t,
+		validateDashboardWidgetQueries([]interface{}{page("FROM Transaction SELECT average(duration)"), page("")}, known),
+		`widget "throughput" on page "queries" (page.1.widget_line.1.nrql_query.1.query) must have a non-empty NRQL query`,
+	)
+	assert.EqualError(t,
+		validateDashboardWidgetQueries([]interface{}{page(" \n ")}, known),
+		`widget "throughput" on page "queries" (page.0.widget_line.1.nrql_query.1.query) must have a non-empty NRQL query`,
+	)
+	assert.NoError(t, validateDashboardWidgetQueries([]interface{}{page("")}, func(string) bool { return false }))
+}
+
+func TestResourceNewRelicOneDashboardCustomizeDiff_EmptyQuery(t *testing.T) {
+	config := func(query string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "dashboard",
+			"page": []interface{}{
+				map[string]interface{}{
+					"name": "page",
+					"widget_billboard": []interface{}{
+						map[string]interface{}{"title": "errors", "row": 1, "column": 1, "nrql_query": []interface{}{
+							map[string]interface{}{"query": query},
+						}},
+					},
+				},
+			},
+		})
+	}
+
+	r := resourceNewRelicOneDashboard()
+
+	_, err := r.Diff(context.Background(), nil, config("FROM TransactionError SELECT count(*)"), nil)
+	assert.NoError(t, err)
+
+	_, err = r.Diff(context.Background(), nil, config(""), nil)
+	assert.EqualError(t, err, `widget "errors" on page "page" (page.0.widget_billboard.0.nrql_query.0.query) must have a non-empty NRQL query`)
+
+	// Queries that are not known yet are validated once they are. This is the value the SDK uses for
+	// unknown values in a raw configuration.
+	_, err = r.Diff(context.Background(), nil, config("74D93920-ED26-11E3-AC10-0800200C9A66"), nil)
+	assert.NoError(t, err)
+}
+
+func TestDashboardWidgetQueryAccountIDs(t *testing.T) {
+	pages := []interface{}{
+		map[string]interface{}{
+			"widget_markdown": []interface{}{map[string]interface{}{"text": "notes"}},
+			"widget_line": []interface{}{
+				map[string]interface{}{"nrql_query": []interface{}{
+					map[string]interface{}{"account_id": 0},
+					map[string]interface{}{"account_id": 2000},
+				}},
+			},
+			"widget_area": []interface{}{
+				map[string]interface{}{"nrql_query": []interface{}{map[string]interface{}{"account_id": 3000}}},
+			},
+		},
+	}
+
+	assert.Equal(t, []dashboardWidgetQueryAccountID{
+		{path: "page.0.widget_area.0.nrql_query.0.account_id", accountID: 3000},
+		{path: "page.0.widget_line.0.nrql_query.1.account_id", accountID: 2000},
+	}, dashboardWidgetQueryAccountIDs(pages))
+}
@@ -34,7 +34,6 @@ func buildSyntheticsBrokenLinksMonitorUpdateInput(d *schema.ResourceData) *synth
 		Name:   inputBase.Name,
 		Period: inputBase.Period,
 		Status: inputBase.Status,
-		Tags:   inputBase.Tags,
 	}
 
 	if attr, ok := d.GetOk("locations_private"); ok {
@@ -51,7 +51,6 @@ func buildSyntheticsScriptAPIMonitorUpdateInput(d *schema.ResourceData) syntheti
 		Name:   inputBase.Name,
 		Period: inputBase.Period,
 		Status: inputBase.Status,
-		Tags:   inputBase.Tags,
 		Script: d.Get("script").(string),
 	}
 
@@ -74,7 +74,6 @@ func buildSyntheticsScriptBrowserUpdateInput(d *schema.ResourceData) synthetics.
 		Name:            inputBase.Name,
 		Period:          inputBase.Period,
 		Status:          inputBase.Status,
-		Tags:            inputBase.Tags,
 		Script:          d.Get("script").(string),
 		AdvancedOptions: synthetics.SyntheticsScriptBrowserMonitorAdvancedOptionsInput{},
 	}
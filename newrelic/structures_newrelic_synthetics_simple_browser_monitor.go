@@ -75,7 +75,6 @@ func buildSyntheticsSimpleBrowserMonitorUpdateStruct(d *schema.ResourceData) syn
 		Name:   inputBase.Name,
 		Period: inputBase.Period,
 		Status: inputBase.Status,
-		Tags:   inputBase.Tags,
 	}
 
 	if v, ok := d.GetOk("custom_header"); ok {
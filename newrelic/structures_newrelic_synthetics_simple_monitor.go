@@ -61,7 +61,6 @@ func buildSyntheticsSimpleMonitorUpdateStruct(d *schema.ResourceData) synthetics
 	simpleMonitorUpdateInput.Name = inputBase.Name
 	simpleMonitorUpdateInput.Period = inputBase.Period
 	simpleMonitorUpdateInput.Status = inputBase.Status
-	simpleMonitorUpdateInput.Tags = inputBase.Tags
 
 	if v, ok := d.GetOk("custom_header"); ok {
 		simpleMonitorUpdateInput.AdvancedOptions.CustomHeaders = expandSyntheticsCustomHeaders(v.(*schema.Set).List())
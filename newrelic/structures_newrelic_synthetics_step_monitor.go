@@ -39,7 +39,6 @@ func buildSyntheticsStepMonitorUpdateInput(d *schema.ResourceData) *synthetics.S
 		Name:   inputBase.Name,
 		Period: inputBase.Period,
 		Status: inputBase.Status,
-		Tags:   inputBase.Tags,
 		Steps:  expandSyntheticsMonitorSteps(d.Get("steps").([]interface{})),
 	}
 
@@ -3,6 +3,7 @@ package newrelic
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
@@ -236,11 +237,13 @@ func expandWorkflowIssuePredicates(predicates []interface{}) []workflows.AiWorkf
 func expandWorkflowIssuePredicate(predicate map[string]interface{}) workflows.AiWorkflowsPredicateInput {
 	var valuesList []string
 
-	for _, v := range predicate["values"].([]interface{}) {
+	for _, v := range predicate["values"].(*schema.Set).List() {
 		vInput := v.(string)
 		valuesList = append(valuesList, vInput)
 	}
 
+	sort.Strings(valuesList)
+
 	return workflows.AiWorkflowsPredicateInput{
 		Attribute: predicate["attribute"].(string),
 		Operator:  workflows.AiWorkflowsOperator(predicate["operator"].(string)),
@@ -450,11 +453,19 @@ func flattenWorkflowPredicate(p *workflows.AiWorkflowsPredicate) (map[string]int
 
 	predicateResult["attribute"] = p.Attribute
 	predicateResult["operator"] = p.Operator
-	predicateResult["values"] = p.Values
+	predicateResult["values"] = sortedWorkflowPredicateValues(p.Values)
 
 	return predicateResult, nil
 }
 
+// The API doesn't keep the order of predicate values, so they are stored sorted.
+func sortedWorkflowPredicateValues(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+
+	return sorted
+}
+
 func flattenWorkflowEnrichments(e *[]workflows.AiWorkflowsEnrichment) ([]interface{}, error) {
 	if e == nil || len(*e) == 0 {
 		return nil, nil
@@ -4,9 +4,12 @@
 package newrelic
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
 
 	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
@@ -361,6 +364,33 @@ func TestFlattenWorkflow(t *testing.T) {
 	}
 }
 
+func TestFlattenWorkflowEnrichments_AccountID(t *testing.T) {
+	enrichments := []workflows.AiWorkflowsEnrichment{{
+		AccountID: 2,
+		ID:        "c6eb3ba4-5d7a-4a29-8fd1-2e8b1c0a7e22",
+		Name:      "Log Count",
+		Type:      workflows.AiWorkflowsEnrichmentTypeTypes.NRQL,
+		Configurations: []ai.AiWorkflowsConfiguration{{
+			Query: "SELECT count(*) FROM Log",
+		}},
+	}}
+
+	flattened, err := flattenWorkflowEnrichments(&enrichments)
+	assert.NoError(t, err)
+
+	d := resourceNewRelicWorkflow().TestResourceData()
+	assert.NoError(t, d.Set("enrichments", flattened))
+
+	enrichmentsSet := d.Get("enrichments").(*schema.Set).List()
+	assert.Len(t, enrichmentsSet, 1)
+	nrql := enrichmentsSet[0].(map[string]interface{})["nrql"].([]interface{})[0].(map[string]interface{})
+
+	// Enrichments run in the account of the workflow, which the API returns for each enrichment
+	assert.Equal(t, 2, nrql["account_id"])
+	assert.Equal(t, "c6eb3ba4-5d7a-4a29-8fd1-2e8b1c0a7e22", nrql["enrichment_id"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"query": "SELECT count(*) FROM Log"}}, nrql["configuration"])
+}
+
 func TestWorkflowStateUpgradeV0(t *testing.T) {
 	expected := testWorkflowStateDataV1()
 	actual, err := migrateStateNewRelicWorkflowV0toV1(nil, testWorkflowStateDataV0(), nil)
@@ -464,3 +494,66 @@ func testFlattenWorkflowsEnrichmentConfiguration(t *testing.T, v interface{}, co
 		}
 	}
 }
+
+func TestFlattenWorkflowPredicateValues_ReorderedIsNotChanged(t *testing.T) {
+	r := resourceNewRelicWorkflow()
+
+	config := map[string]interface{}{
+		"name":                  "workflow-test",
+		"muting_rules_handling": "NOTIFY_ALL_ISSUES",
+		"issues_filter": []interface{}{map[string]interface{}{
+			"name": "issues-filter-test",
+			"type": "FILTER",
+			"predicate": []interface{}{map[string]interface{}{
+				"attribute": "accumulations.tag.team",
+				"operator":  "EXACTLY_MATCHES",
+				"values":    []interface{}{"growth", "billing", "search"},
+			}},
+		}},
+		"destination": []interface{}{map[string]interface{}{
+			"channel_id":            "300848f9-c713-463c-9036-40b45c4c970f",
+			"notification_triggers": []interface{}{"ACTIVATED"},
+		}},
+	}
+
+	for _, values := range [][]string{
+		{"growth", "billing", "search"},
+		{"search", "growth", "billing"},
+		{"billing", "search", "growth"},
+	} {
+		workflow := &workflows.AiWorkflowsWorkflow{
+			Name:                "workflow-test",
+			AccountID:           1,
+			EnrichmentsEnabled:  true,
+			DestinationsEnabled: true,
+			WorkflowEnabled:     true,
+			MutingRulesHandling: workflows.AiWorkflowsMutingRulesHandlingTypes.NOTIFY_ALL_ISSUES,
+			DestinationConfigurations: []workflows.AiWorkflowsDestinationConfiguration{{
+				Name:                 "destination-test",
+				Type:                 workflows.AiWorkflowsDestinationTypeTypes.WEBHOOK,
+				ChannelId:            "300848f9-c713-463c-9036-40b45c4c970f",
+				NotificationTriggers: []workflows.AiWorkflowsNotificationTrigger{workflows.AiWorkflowsNotificationTriggerTypes.ACTIVATED},
+			}},
+			IssuesFilter: workflows.AiWorkflowsFilter{
+				Name: "issues-filter-test",
+				Type: workflows.AiWorkflowsFilterTypeTypes.FILTER,
+				Predicates: []workflows.AiWorkflowsPredicate{{
+					Attribute: "accumulations.tag.team",
+					Operator:  workflows.AiWorkflowsOperatorTypes.EXACTLY_MATCHES,
+					Values:    values,
+				}},
+			},
+		}
+
+		d := r.TestResourceData()
+		d.SetId("5d3d2c5b-5f8a-4a57-9e1b-4b5b8e3a9f44")
+		assert.NoError(t, flattenWorkflow(workflow, d))
+
+		diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+		assert.NoError(t, err)
+		assert.True(t, diff == nil || diff.Empty(), "%v: %v", values, diff)
+
+		predicate := expandWorkflowIssuePredicate(d.Get("issues_filter").(*schema.Set).List()[0].(map[string]interface{})["predicate"].([]interface{})[0].(map[string]interface{}))
+		assert.Equal(t, []string{"billing", "growth", "search"}, predicate.Values)
+	}
+}
@@ -46,9 +46,9 @@ func expandWorkloadUpdateInput(d *schema.ResourceData) workloads.WorkloadUpdateI
 		StatusConfig: &workloads.WorkloadUpdateStatusConfigInput{},
 	}
 
-	if e, ok := d.GetOk("entity_guids"); ok {
-		updateInput.EntityGUIDs = expandWorkloadEntityGUIDs(e.(*schema.Set).List())
-	}
+	// The entity GUIDs are always sent, an empty list removes all the manually assigned entities
+	// while keeping the entities matched by the search queries.
+	updateInput.EntityGUIDs = expandWorkloadEntityGUIDs(d.Get("entity_guids").(*schema.Set).List())
 
 	if e, ok := d.GetOk("entity_search_query"); ok {
 		updateInput.EntitySearchQueries = expandWorkloadUpdateCollectionEntitySearchQueryInputs(e.(*schema.Set).List())
@@ -387,8 +387,17 @@ func flattenWorkload(workload *workloads.WorkloadCollection, d *schema.ResourceD
 	_ = d.Set("name", workload.Name)
 	_ = d.Set("permalink", workload.Permalink)
 	_ = d.Set("composite_entity_search_query", workload.EntitySearchQuery)
-	_ = d.Set("entity_guids", flattenWorkloadEntityGUIDs(workload.Entities))
-	_ = d.Set("entity_search_query", flattenWorkloadEntitySearchQueries(workload.EntitySearchQueries))
+
+	// Entities assigned explicitly and search queries are returned separately, so each attribute
+	// only holds what was configured for it.
+	if err := d.Set("entity_guids", flattenWorkloadEntityGUIDs(workload.Entities)); err != nil {
+		return err
+	}
+
+	if err := d.Set("entity_search_query", flattenWorkloadEntitySearchQueries(workload.EntitySearchQueries)); err != nil {
+		return err
+	}
+
 	_ = d.Set("scope_account_ids", workload.ScopeAccounts.AccountIDs)
 
 	if workload.Description != "" {
@@ -402,9 +411,61 @@ func flattenWorkload(workload *workloads.WorkloadCollection, d *schema.ResourceD
 		}
 	}
 
+	// The API returns a disabled automatic configuration for workloads that never had one, so it is
+	// only set once it holds something, which also rebuilds it on import.
+	if workloadStatusConfigAutomaticConfigured(workload.StatusConfig.Automatic) {
+		statusAutomatic := flattenStatusConfigAutomatic(workload.StatusConfig.Automatic)
+		if err := d.Set("status_config_automatic", statusAutomatic); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func workloadStatusConfigAutomaticConfigured(in workloads.WorkloadAutomaticStatus) bool {
+	return in.Enabled || len(in.Rules) > 0 || in.RemainingEntitiesRule.Rollup.Strategy != ""
+}
+
+func flattenStatusConfigAutomatic(in workloads.WorkloadAutomaticStatus) []interface{} {
+	m := make(map[string]interface{})
+	m["enabled"] = in.Enabled
+
+	if in.RemainingEntitiesRule.Rollup.Strategy != "" {
+		rollup := in.RemainingEntitiesRule.Rollup
+		m["remaining_entities_rule"] = []interface{}{
+			map[string]interface{}{
+				"remaining_entities_rule_rollup": []interface{}{
+					map[string]interface{}{
+						"group_by":        string(rollup.GroupBy),
+						"strategy":        string(rollup.Strategy),
+						"threshold_type":  string(rollup.ThresholdType),
+						"threshold_value": rollup.ThresholdValue,
+					},
+				},
+			},
+		}
+	}
+
+	rules := make([]interface{}, len(in.Rules))
+	for i, r := range in.Rules {
+		rules[i] = map[string]interface{}{
+			"entity_guids": flattenWorkloadEntityGUIDs(r.Entities),
+			"nrql_query":   flattenWorkloadEntitySearchQueries(r.EntitySearchQueries),
+			"rollup": []interface{}{
+				map[string]interface{}{
+					"strategy":        string(r.Rollup.Strategy),
+					"threshold_type":  string(r.Rollup.ThresholdType),
+					"threshold_value": r.Rollup.ThresholdValue,
+				},
+			},
+		}
+	}
+	m["rule"] = rules
+
+	return []interface{}{m}
+}
+
 func flattenStatusConfigStatic(in []workloads.WorkloadStaticStatus) []interface{} {
 	out := make([]interface{}, len(in))
 
@@ -429,7 +490,7 @@ func flattenStatusConfigStatic(in []workloads.WorkloadStaticStatus) []interface{
 func flattenWorkloadEntityGUIDs(in []workloads.WorkloadEntityRef) interface{} {
 	out := make([]interface{}, len(in))
 	for i, e := range in {
-		out[i] = e.GUID
+		out[i] = string(e.GUID)
 	}
 	return out
 }
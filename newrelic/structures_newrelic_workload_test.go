@@ -0,0 +1,173 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workloads"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkloadEntityGUIDs = []string{
+	"MjUyMDUyOHxBUE18QVBQTElDQVRJT058MQ",
+	"MjUyMDUyOHxBUE18QVBQTElDQVRJT058Mg",
+	"MjUyMDUyOHxBUE18QVBQTElDQVRJT058Mw",
+}
+
+func testWorkloadEntityGUIDsAndSearchQueryData() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         "workload",
+		"entity_guids": []interface{}{testWorkloadEntityGUIDs[0], testWorkloadEntityGUIDs[1], testWorkloadEntityGUIDs[2]},
+		"entity_search_query": []interface{}{
+			map[string]interface{}{"query": "type = 'DASHBOARD'"},
+		},
+	}
+}
+
+func TestExpandWorkloadInput_EntityGUIDsAndSearchQuery(t *testing.T) {
+	r := resourceNewRelicWorkload()
+	d := r.TestResourceData()
+
+	for k, v := range testWorkloadEntityGUIDsAndSearchQueryData() {
+		require.NoError(t, d.Set(k, v))
+	}
+
+	createInput := expandWorkloadCreateInput(d)
+	require.ElementsMatch(t, []common.EntityGUID{
+		common.EntityGUID(testWorkloadEntityGUIDs[0]),
+		common.EntityGUID(testWorkloadEntityGUIDs[1]),
+		common.EntityGUID(testWorkloadEntityGUIDs[2]),
+	}, createInput.EntityGUIDs)
+	require.Equal(t, []workloads.WorkloadEntitySearchQueryInput{{Query: "type = 'DASHBOARD'"}}, createInput.EntitySearchQueries)
+
+	updateInput := expandWorkloadUpdateInput(d)
+	require.Len(t, updateInput.EntityGUIDs, 3)
+	require.Equal(t, []workloads.WorkloadUpdateCollectionEntitySearchQueryInput{{Query: "type = 'DASHBOARD'"}}, updateInput.EntitySearchQueries)
+}
+
+func TestExpandWorkloadUpdateInput_NoEntityGUIDs(t *testing.T) {
+	r := resourceNewRelicWorkload()
+	d := r.TestResourceData()
+
+	require.NoError(t, d.Set("entity_search_query", []interface{}{
+		map[string]interface{}{"query": "type = 'DASHBOARD'"},
+	}))
+
+	updateInput := expandWorkloadUpdateInput(d)
+	require.NotNil(t, updateInput.EntityGUIDs)
+	require.Empty(t, updateInput.EntityGUIDs)
+	require.Len(t, updateInput.EntitySearchQueries, 1)
+}
+
+func TestFlattenWorkload_EntityGUIDsAndSearchQuery(t *testing.T) {
+	r := resourceNewRelicWorkload()
+	d := r.TestResourceData()
+
+	workload := &workloads.WorkloadCollection{
+		Name: "workload",
+		Entities: []workloads.WorkloadEntityRef{
+			{GUID: common.EntityGUID(testWorkloadEntityGUIDs[2])},
+			{GUID: common.EntityGUID(testWorkloadEntityGUIDs[0])},
+			{GUID: common.EntityGUID(testWorkloadEntityGUIDs[1])},
+		},
+		EntitySearchQueries: []workloads.WorkloadEntitySearchQuery{
+			{Query: "type = 'DASHBOARD'"},
+		},
+	}
+
+	require.NoError(t, flattenWorkload(workload, d))
+
+	guids := d.Get("entity_guids").(*schema.Set).List()
+	require.ElementsMatch(t, []interface{}{testWorkloadEntityGUIDs[0], testWorkloadEntityGUIDs[1], testWorkloadEntityGUIDs[2]}, guids)
+
+	queries := d.Get("entity_search_query").(*schema.Set).List()
+	require.Len(t, queries, 1)
+	require.Equal(t, "type = 'DASHBOARD'", queries[0].(map[string]interface{})["query"])
+}
+
+func TestFlattenWorkload_StatusConfigRoundTrip(t *testing.T) {
+	r := resourceNewRelicWorkload()
+	configured := r.TestResourceData()
+
+	for k, v := range testWorkloadEntityGUIDsAndSearchQueryData() {
+		require.NoError(t, configured.Set(k, v))
+	}
+	require.NoError(t, configured.Set("scope_account_ids", []interface{}{1, 2}))
+	require.NoError(t, configured.Set("status_config_static", []interface{}{
+		map[string]interface{}{"enabled": true, "status": "OPERATIONAL", "description": "test", "summary": "summary"},
+	}))
+	require.NoError(t, configured.Set("status_config_automatic", []interface{}{
+		map[string]interface{}{
+			"enabled": true,
+			"remaining_entities_rule": []interface{}{
+				map[string]interface{}{
+					"remaining_entities_rule_rollup": []interface{}{
+						map[string]interface{}{"strategy": "BEST_STATUS_WINS", "threshold_type": "FIXED", "threshold_value": 100, "group_by": "ENTITY_TYPE"},
+					},
+				},
+			},
+			"rule": []interface{}{
+				map[string]interface{}{
+					"entity_guids": []interface{}{testWorkloadEntityGUIDs[0]},
+					"nrql_query":   []interface{}{map[string]interface{}{"query": "name like 'ok'"}},
+					"rollup": []interface{}{
+						map[string]interface{}{"strategy": "WORST_STATUS_WINS", "threshold_type": "PERCENTAGE", "threshold_value": 50},
+					},
+				},
+			},
+		},
+	}))
+
+	createInput := expandWorkloadCreateInput(configured)
+
+	// Simulate the API returning the workload that was created
+	workload := &workloads.WorkloadCollection{Name: createInput.Name}
+	for _, guid := range createInput.EntityGUIDs {
+		workload.Entities = append(workload.Entities, workloads.WorkloadEntityRef{GUID: guid})
+	}
+	for _, q := range createInput.EntitySearchQueries {
+		workload.EntitySearchQueries = append(workload.EntitySearchQueries, workloads.WorkloadEntitySearchQuery{Query: q.Query})
+	}
+	workload.ScopeAccounts.AccountIDs = createInput.ScopeAccounts.AccountIDs
+	for _, s := range createInput.StatusConfig.Static {
+		workload.StatusConfig.Static = append(workload.StatusConfig.Static, workloads.WorkloadStaticStatus{
+			Description: s.Description,
+			Enabled:     s.Enabled,
+			Status:      workloads.WorkloadStatusValue(s.Status),
+			Summary:     s.Summary,
+		})
+	}
+	automatic := createInput.StatusConfig.Automatic
+	workload.StatusConfig.Automatic.Enabled = automatic.Enabled
+	workload.StatusConfig.Automatic.RemainingEntitiesRule.Rollup = workloads.WorkloadRemainingEntitiesRuleRollup(*automatic.RemainingEntitiesRule.Rollup)
+	for _, rule := range automatic.Rules {
+		regular := workloads.WorkloadRegularRule{Rollup: workloads.WorkloadRollup(*rule.Rollup)}
+		for _, guid := range rule.EntityGUIDs {
+			regular.Entities = append(regular.Entities, workloads.WorkloadEntityRef{GUID: guid})
+		}
+		for _, q := range rule.EntitySearchQueries {
+			regular.EntitySearchQueries = append(regular.EntitySearchQueries, workloads.WorkloadEntitySearchQuery{Query: q.Query})
+		}
+		workload.StatusConfig.Automatic.Rules = append(workload.StatusConfig.Automatic.Rules, regular)
+	}
+
+	// Reading into empty data, as on import, rebuilds the configured attributes
+	imported := r.TestResourceData()
+	require.NoError(t, flattenWorkload(workload, imported))
+
+	for _, attr := range []string{"entity_guids", "entity_search_query", "scope_account_ids", "status_config_static", "status_config_automatic"} {
+		require.True(t, configured.Get(attr).(*schema.Set).Equal(imported.Get(attr)), attr)
+	}
+}
+
+func TestFlattenWorkload_StatusConfigAutomaticNotConfigured(t *testing.T) {
+	d := resourceNewRelicWorkload().TestResourceData()
+
+	require.NoError(t, flattenWorkload(&workloads.WorkloadCollection{Name: "workload"}, d))
+	require.Zero(t, d.Get("status_config_automatic").(*schema.Set).Len())
+}
@@ -2,6 +2,7 @@ package newrelic
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
 
@@ -43,3 +44,51 @@ func buildAiWorkflowsDeleteResponseError(errors []workflows.AiWorkflowsDeleteRes
 	}
 	return diagErrors
 }
+
+// Looks up each destination channel ID and returns an error listing the channels that don't exist.
+func validateWorkflowDestinationChannels(channelIDs []string, channelExists func(channelID string) (bool, error)) error {
+	var missing []string
+	for _, channelID := range channelIDs {
+		exists, err := channelExists(channelID)
+		if err != nil {
+			return fmt.Errorf("failed to look up notification channel %s: %w", channelID, err)
+		}
+
+		if !exists {
+			missing = append(missing, channelID)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("workflow destination references notification channels that do not exist: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// A FILTER issues filter matches issues by its predicates, so it needs at least one,
+// while a VIEW refers to a saved view and must not define any.
+func validateWorkflowIssuesFilters(filters []interface{}) error {
+	for _, f := range filters {
+		filter, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		filterType := filter["type"].(string)
+		predicates := filter["predicate"].([]interface{})
+
+		switch workflows.AiWorkflowsFilterType(filterType) {
+		case workflows.AiWorkflowsFilterTypeTypes.FILTER:
+			if len(predicates) == 0 {
+				return fmt.Errorf("issues_filter %q of type %s requires at least one predicate", filter["name"], filterType)
+			}
+		case workflows.AiWorkflowsFilterTypeTypes.VIEW:
+			if len(predicates) > 0 {
+				return fmt.Errorf("issues_filter %q of type %s must not have predicates", filter["name"], filterType)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testMockWorkflowChannelLookup(existing ...string) func(string) (bool, error) {
+	return func(channelID string) (bool, error) {
+		for _, id := range existing {
+			if id == channelID {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func TestValidateWorkflowDestinationChannels(t *testing.T) {
+	t.Parallel()
+
+	err := validateWorkflowDestinationChannels([]string{"channel-1", "channel-2"}, testMockWorkflowChannelLookup("channel-1", "channel-2"))
+
+	require.NoError(t, err)
+}
+
+func TestValidateWorkflowDestinationChannels_Missing(t *testing.T) {
+	t.Parallel()
+
+	err := validateWorkflowDestinationChannels([]string{"channel-1", "channel-2", "channel-3"}, testMockWorkflowChannelLookup("channel-2"))
+
+	require.EqualError(t, err, "workflow destination references notification channels that do not exist: channel-1, channel-3")
+}
+
+func TestValidateWorkflowDestinationChannels_LookupError(t *testing.T) {
+	t.Parallel()
+
+	err := validateWorkflowDestinationChannels([]string{"channel-1"}, func(string) (bool, error) {
+		return false, fmt.Errorf("rate limited")
+	})
+
+	require.EqualError(t, err, "failed to look up notification channel channel-1: rate limited")
+}
+
+func TestValidateWorkflowIssuesFilters(t *testing.T) {
+	predicate := map[string]interface{}{
+		"attribute": "accumulations.tag.team",
+		"operator":  "EXACTLY_MATCHES",
+		"values":    []interface{}{"growth"},
+	}
+
+	cases := map[string]struct {
+		filter      map[string]interface{}
+		expectedErr string
+	}{
+		"filter with predicates": {
+			filter: map[string]interface{}{"name": "team", "type": "FILTER", "predicate": []interface{}{predicate}},
+		},
+		"view without predicates": {
+			filter: map[string]interface{}{"name": "saved view", "type": "VIEW", "predicate": []interface{}{}},
+		},
+		"filter without predicates": {
+			filter:      map[string]interface{}{"name": "team", "type": "FILTER", "predicate": []interface{}{}},
+			expectedErr: `issues_filter "team" of type FILTER requires at least one predicate`,
+		},
+		"view with predicates": {
+			filter:      map[string]interface{}{"name": "saved view", "type": "VIEW", "predicate": []interface{}{predicate}},
+			expectedErr: `issues_filter "saved view" of type VIEW must not have predicates`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateWorkflowIssuesFilters([]interface{}{tc.filter})
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}